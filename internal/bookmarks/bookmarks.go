@@ -0,0 +1,156 @@
+package bookmarks
+
+import (
+	"encoding/json"
+	"errors"
+	"fmt"
+	"net/url"
+	"os"
+	"path/filepath"
+	"strings"
+	"time"
+
+	"hacktober/internal/github"
+)
+
+// Bookmark is an issue saved to come back to in a later session
+type Bookmark struct {
+	Owner      string    `json:"owner"`
+	Repo       string    `json:"repo"`
+	Number     int       `json:"number"`
+	Title      string    `json:"title"`
+	URL        string    `json:"url"`
+	Difficulty string    `json:"difficulty,omitempty"` // band when bookmarked, e.g. "Easy"
+	AddedAt    time.Time `json:"added_at"`
+}
+
+// Ref returns the bookmarked issue as "owner/repo#number"
+func (b Bookmark) Ref() string {
+	return fmt.Sprintf("%s/%s#%d", b.Owner, b.Repo, b.Number)
+}
+
+// matches reports whether the bookmark is for the given issue. GitHub
+// owner and repository names are case-insensitive.
+func (b Bookmark) matches(owner, repo string, number int) bool {
+	return b.Number == number && strings.EqualFold(b.Owner, owner) && strings.EqualFold(b.Repo, repo)
+}
+
+// GetBookmarksLocation returns the path of the bookmarks file
+func GetBookmarksLocation() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "bookmarks.json")
+}
+
+// List returns the bookmarks in the order they were added. A missing file
+// means there are none.
+func List() ([]Bookmark, error) {
+	data, err := os.ReadFile(GetBookmarksLocation())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	var bookmarks []Bookmark
+	if err := json.Unmarshal(data, &bookmarks); err != nil {
+		return nil, err
+	}
+	return bookmarks, nil
+}
+
+// Contains reports whether the issue owner/repo#number is bookmarked
+func Contains(owner, repo string, number int) (bool, error) {
+	bookmarks, err := List()
+	if err != nil {
+		return false, err
+	}
+	for _, bookmark := range bookmarks {
+		if bookmark.matches(owner, repo, number) {
+			return true, nil
+		}
+	}
+	return false, nil
+}
+
+// Add bookmarks an issue. Adding an issue that is already bookmarked keeps
+// the existing bookmark.
+func Add(issue *github.Issue) error {
+	owner, repo, ok := IssueRepo(issue)
+	if !ok {
+		return fmt.Errorf("can't tell which repository issue #%d belongs to", issue.Issue.GetNumber())
+	}
+
+	bookmarks, err := List()
+	if err != nil {
+		return err
+	}
+	for _, bookmark := range bookmarks {
+		if bookmark.matches(owner, repo, issue.Issue.GetNumber()) {
+			return nil
+		}
+	}
+
+	bookmarks = append(bookmarks, Bookmark{
+		Owner:      owner,
+		Repo:       repo,
+		Number:     issue.Issue.GetNumber(),
+		Title:      issue.Issue.GetTitle(),
+		URL:        issue.Issue.GetHTMLURL(),
+		Difficulty: github.DifficultyBand(issue.DifficultyScore),
+		AddedAt:    time.Now(),
+	})
+	return save(bookmarks)
+}
+
+// Remove deletes the bookmark of owner/repo#number, if there is one
+func Remove(owner, repo string, number int) error {
+	bookmarks, err := List()
+	if err != nil {
+		return err
+	}
+
+	kept := bookmarks[:0]
+	for _, bookmark := range bookmarks {
+		if !bookmark.matches(owner, repo, number) {
+			kept = append(kept, bookmark)
+		}
+	}
+	if len(kept) == len(bookmarks) {
+		return nil
+	}
+	return save(kept)
+}
+
+// IssueRepo returns the owner and name of the repository an issue belongs to,
+// from its repository or, for issues fetched without one, its URLs
+func IssueRepo(issue *github.Issue) (string, string, bool) {
+	if issue.Repository != nil {
+		return issue.Repository.GetOwner().GetLogin(), issue.Repository.GetName(), true
+	}
+	if _, path, ok := strings.Cut(issue.Issue.GetRepositoryURL(), "/repos/"); ok {
+		if owner, repo, ok := strings.Cut(path, "/"); ok {
+			return owner, repo, true
+		}
+	}
+	if parsed, err := url.Parse(issue.Issue.GetHTMLURL()); err == nil {
+		if parts := strings.Split(strings.Trim(parsed.Path, "/"), "/"); len(parts) >= 2 {
+			return parts[0], parts[1], true
+		}
+	}
+	return "", "", false
+}
+
+// save writes the bookmarks to disk
+func save(bookmarks []Bookmark) error {
+	path := GetBookmarksLocation()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(bookmarks, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
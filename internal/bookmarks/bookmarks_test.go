@@ -0,0 +1,51 @@
+package bookmarks
+
+import (
+	"testing"
+
+	gh "github.com/google/go-github/v56/github"
+
+	"hacktober/internal/github"
+)
+
+func testIssue(repoURL string, number int) *github.Issue {
+	return &github.Issue{Issue: &gh.Issue{
+		Number:        gh.Int(number),
+		Title:         gh.String("Fix the docs"),
+		RepositoryURL: gh.String(repoURL),
+		HTMLURL:       gh.String("https://github.com/octo/docs/issues/7"),
+	}}
+}
+
+func TestBookmarksDedupeAndSurviveReload(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+
+	if err := Add(testIssue("https://api.github.com/repos/octo/docs", 7)); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	// The same issue with differently cased names is still one bookmark
+	if err := Add(testIssue("https://api.github.com/repos/Octo/Docs", 7)); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+	if err := Add(testIssue("https://api.github.com/repos/octo/docs", 8)); err != nil {
+		t.Fatalf("Add returned error: %v", err)
+	}
+
+	list, err := List()
+	if err != nil {
+		t.Fatalf("List returned error: %v", err)
+	}
+	if len(list) != 2 || list[0].Ref() != "octo/docs#7" || list[1].Ref() != "octo/docs#8" {
+		t.Fatalf("bookmarks = %v, want octo/docs#7 and octo/docs#8", list)
+	}
+
+	if err := Remove("octo", "docs", 7); err != nil {
+		t.Fatalf("Remove returned error: %v", err)
+	}
+	if ok, err := Contains("octo", "docs", 7); err != nil || ok {
+		t.Errorf("Contains after Remove = %v, %v, want false, nil", ok, err)
+	}
+	if ok, err := Contains("octo", "docs", 8); err != nil || !ok {
+		t.Errorf("Contains for the remaining bookmark = %v, %v, want true, nil", ok, err)
+	}
+}
@@ -0,0 +1,294 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"hacktober/internal/github"
+)
+
+// screenName returns a human readable name for a screen, used by accessible mode
+func screenName(s screen) string {
+	switch s {
+	case welcomeScreen:
+		return "Welcome"
+	case repoListScreen:
+		return "Repository List"
+	case issueListScreen:
+		return "Issue List"
+	case issueDetailScreen:
+		return "Issue Detail"
+	case readmeScreen:
+		return "README"
+	case historyScreen:
+		return "Search History"
+	case bookmarksScreen:
+		return "Bookmarked Issues"
+	case scanScreen:
+		return "Good First Issue Scan"
+	case completedScreen:
+		return "Completed Issues"
+	case tunerScreen:
+		return "Tune Relevance Weights"
+	case labelsScreen:
+		return "Labels"
+	case topicsScreen:
+		return "Topics"
+	}
+	return "Unknown"
+}
+
+// accessibleView renders the current screen as plain, linear text without
+// borders, colors or emoji so it can be followed with a screen reader
+func (m Model) accessibleView() string {
+	lines := []string{fmt.Sprintf("Screen: %s", screenName(m.currentScreen))}
+
+	if m.loading {
+		return strings.Join(append(lines, "Status: Loading, please wait."), "\n")
+	}
+
+	if m.prTarget != nil {
+		return strings.Join(append(lines,
+			fmt.Sprintf("Marking %s as completed.", issueKey(m.prTarget)),
+			fmt.Sprintf("Type the pull request URL, optional: %s", m.prInput.Value()),
+			"Keys: enter to save, escape to cancel.",
+		), "\n")
+	}
+
+	if m.error != nil {
+		return strings.Join(append(lines,
+			fmt.Sprintf("Error: %v", m.error),
+			"Keys: q to go back, r to retry.",
+		), "\n")
+	}
+
+	switch m.currentScreen {
+	case welcomeScreen:
+		lines = append(lines,
+			"Hacktoberfest Repository and Issue Explorer.",
+			fmt.Sprintf("Languages: %s.", strings.Join(m.config.PreferredLanguages, ", ")),
+			fmt.Sprintf("Skill level: %s.", m.config.SkillLevel),
+			fmt.Sprintf("Stars: %s.", starRange(m.config.MinStars, m.config.MaxStars)),
+		)
+		if m.confirmReset {
+			lines = append(lines, resetPrompt)
+			break
+		}
+		if m.promptRepo {
+			lines = append(lines, "Type a repository as owner/name, enter to open its issues, escape to cancel.", m.repoInput.View())
+		}
+		if m.status != "" {
+			lines = append(lines, fmt.Sprintf("Status: %s", m.status))
+		}
+		lines = append(lines, "Keys: enter to search repositories, o to open a repository by name, w for watchlist, shift+h for search history, shift+c for completed issues, shift+b for bookmarks, shift+r to reset settings, f to go forward, ctrl+c to quit.")
+
+	case repoListScreen:
+		items := m.repoList.Items()
+		if m.incomplete {
+			lines = append(lines, "Partial results: GitHub timed out on the search. Press r to retry.")
+		}
+		if len(items) == 0 {
+			lines = append(lines, "No repositories found.")
+			lines = append(lines, m.emptyRepoSuggestions()...)
+			if !m.relaxExhausted {
+				lines = append(lines, "Press y to relax filters and retry.")
+			}
+		} else {
+			if !m.cachedAt.IsZero() {
+				lines = append(lines, "Showing cached results from the last session while refreshing.")
+			}
+			lines = append(lines, fmt.Sprintf("Page %d. Item %d of %d selected.",
+				m.currentPage, m.repoList.Index()+1, len(items)))
+			if item, ok := m.repoList.SelectedItem().(repoItem); ok {
+				repo := item.repo.Repository
+				stars := formatStars(item.repo)
+				if repo.StargazersCount == nil {
+					stars = "unknown"
+				}
+				summary := fmt.Sprintf("Stars: %s. Language: %s.", stars, repo.GetLanguage())
+				switch repo.GetOwner().GetType() {
+				case "Organization":
+					summary += " Owned by an organization."
+				case "User":
+					summary += " Owned by a personal account."
+				}
+				if m.config.ShowScores {
+					summary += fmt.Sprintf(" Score: %s.", FormatScore(item.repo.RelevanceScore))
+				}
+				lines = append(lines,
+					fmt.Sprintf("Repository: %s/%s.", repo.GetOwner().GetLogin(), repo.GetName()),
+					summary,
+				)
+				lines = append(lines, fmt.Sprintf("Relevant because: %s.", item.repo.ExplainRelevance()))
+				if item.isNew {
+					lines = append(lines, "New since the last refresh.")
+				}
+				if item.repo.Broadened {
+					lines = append(lines, fmt.Sprintf("Found by a broadened search of %s.", m.broadenedLanguages()))
+				}
+				if item.repo.Dependency {
+					lines = append(lines, "Your projects depend on this repository.")
+				}
+				if item.repo.Contributed {
+					lines = append(lines, "You already contribute to this repository.")
+				} else if item.repo.Starred {
+					lines = append(lines, "You starred this repository.")
+				}
+				if repo.GetDescription() != "" {
+					lines = append(lines, fmt.Sprintf("Description: %s", repo.GetDescription()))
+				}
+			}
+		}
+		lines = append(lines, "Keys: up and down to move, left and right to change page, enter to open, i for issues, m for README, plus and minus for more or fewer repositories per page, e to export them as CSV, shift+l to browse their topics, f1 to f4 for filter presets, q to go back, f to go forward.")
+
+	case issueListScreen:
+		if m.confirmExport {
+			lines = append(lines, exportPrompt)
+			break
+		}
+		items := m.issueList.Items()
+		if len(items) == 0 {
+			lines = append(lines, "No issues found.")
+		} else {
+			lines = append(lines, fmt.Sprintf("Item %d of %d selected.", m.issueList.Index()+1, len(items)))
+			if item, ok := m.issueList.SelectedItem().(issueItem); ok {
+				issue := item.issue.Issue
+				lines = append(lines,
+					fmt.Sprintf("Issue %d: %s.", issue.GetNumber(), issue.GetTitle()),
+					fmt.Sprintf("Difficulty: %s. Comments: %d.", difficultyName(item.issue.DifficultyScore), issue.GetComments()),
+				)
+				if item.similarTo != 0 {
+					lines = append(lines, fmt.Sprintf("Warning: title is similar to issue %d.", item.similarTo))
+				}
+				if item.issue.Commented {
+					lines = append(lines, "You have commented on this issue.")
+				}
+				if item.issue.Assigned {
+					lines = append(lines, "Assigned to someone, likely claimed.")
+				}
+			}
+		}
+		lines = append(lines, "Keys: up and down to move, enter to open, d for details, b to jump to the best issue for your skill level, s to open a random issue, shift+l to browse labels, shift+k to cycle contribution types, f1 to f4 for filter presets, x to toggle excluded labels and issues you commented on, c to mark completed, z to snooze, a to mark all visible issues as seen, e to export the issues as a task list, JSON or a markdown table, shift+b to bookmark, p to copy the issue as a card, shift+p to copy it in the other format, q to go back, f to go forward.")
+
+	case issueDetailScreen:
+		if m.selectedIssue == nil {
+			lines = append(lines, "No issue selected.")
+			break
+		}
+		issue := m.selectedIssue.Issue
+		author := issue.GetUser().GetLogin()
+		if role := authorRole(m.selectedIssue); m.config.ShowAuthorAssociation && role != "" {
+			author += ", " + role
+		}
+		lines = append(lines,
+			fmt.Sprintf("Issue %d: %s.", issue.GetNumber(), issue.GetTitle()),
+			fmt.Sprintf("Author: %s.", author),
+			fmt.Sprintf("Difficulty: %s.", difficultyName(m.selectedIssue.DifficultyScore)),
+			fmt.Sprintf("URL: %s", issue.GetHTMLURL()),
+		)
+		if wait := m.selectedIssue.FirstResponse; wait != nil {
+			lines = append(lines, fmt.Sprintf("First response after %s.", formatWait(*wait)))
+		}
+		if milestone := issue.GetMilestone(); milestone != nil {
+			line := fmt.Sprintf("Milestone: %s.", milestone.GetTitle())
+			if milestone.DueOn != nil {
+				line = fmt.Sprintf("Milestone: %s, due %s.", milestone.GetTitle(), milestone.DueOn.Format("January 2, 2006"))
+			}
+			lines = append(lines, line)
+		}
+		for _, card := range m.projects {
+			lines = append(lines, fmt.Sprintf("Project: %s, column %s.", card.Project, card.Column))
+		}
+		for _, pr := range m.linkedPRs {
+			lines = append(lines, fmt.Sprintf("Linked pull request %s number %d: %s, %s.", pr.Repository, pr.Number, pr.Title, pr.State))
+		}
+		if latest, ok := github.LatestSignal(m.activity); ok {
+			lines = append(lines, fmt.Sprintf("Timeline suggests the issue is %s. Latest sign, on %s, %s: %s.",
+				latest.Signal, latest.At.Format("January 2"), latest.Actor, latest.Summary))
+		}
+		if issue.GetBody() != "" {
+			lines = append(lines, "Description:", issue.GetBody())
+		}
+		lines = append(lines, "Keys: o to open in the browser, c to mark completed, z to snooze, shift+b to bookmark, p to copy the issue as a card, shift+p to copy it in the other format, q to go back.")
+
+	case readmeScreen:
+		if len(m.commitActivity) > 0 {
+			recent := sumInts(m.commitActivity[max(0, len(m.commitActivity)-4):])
+			lines = append(lines, fmt.Sprintf("Commit activity: %d commits over the last %d weeks, %d in the last 4.",
+				sumInts(m.commitActivity), len(m.commitActivity), recent))
+		}
+		lines = append(lines, m.readmeView.View(), "Keys: up and down to scroll, q to go back.")
+
+	case historyScreen:
+		if item, ok := m.historyList.SelectedItem().(historyItem); ok {
+			lines = append(lines,
+				fmt.Sprintf("Search %d of %d selected.", m.historyList.Index()+1, len(m.historyList.Items())),
+				item.Title()+".")
+		} else {
+			lines = append(lines, "No searches recorded yet.")
+		}
+		lines = append(lines, "Keys: up and down to move, enter to run the search again, q to go back.")
+
+	case bookmarksScreen:
+		if item, ok := m.bookmarksList.SelectedItem().(bookmarkItem); ok {
+			lines = append(lines,
+				fmt.Sprintf("Bookmark %d of %d selected.", m.bookmarksList.Index()+1, len(m.bookmarksList.Items())),
+				fmt.Sprintf("%s: %s.", item.bookmark.Ref(), item.bookmark.Title),
+				fmt.Sprintf("URL: %s", item.bookmark.URL))
+		} else {
+			lines = append(lines, "No bookmarks yet.")
+		}
+		if m.status != "" {
+			lines = append(lines, fmt.Sprintf("Status: %s", m.status))
+		}
+		lines = append(lines, "Keys: up and down to move, enter to open in the browser, shift+b to remove the bookmark, e to export the bookmarks as a task list, q to go back.")
+
+	case completedScreen:
+		lines = append(lines, m.completedView.View(), "Keys: up and down to scroll, q to go back.")
+
+	case labelsScreen:
+		if item, ok := m.labelList.SelectedItem().(labelItem); ok {
+			lines = append(lines, fmt.Sprintf("Label %d of %d: %s, on %d issues.",
+				m.labelList.Index()+1, len(m.labelList.VisibleItems()), item.name, item.count))
+		}
+		lines = append(lines, "Keys: up and down to move, slash to filter, enter to show issues with this label, q to go back.")
+
+	case topicsScreen:
+		if item, ok := m.topicList.SelectedItem().(topicItem); ok {
+			lines = append(lines, fmt.Sprintf("Topic %d of %d: %s, on %d repositories.",
+				m.topicList.Index()+1, len(m.topicList.VisibleItems()), item.name, item.count))
+		}
+		lines = append(lines, "Keys: up and down to move, slash to filter, enter to show repositories with this topic, q to go back.")
+
+	case tunerScreen:
+		for i, weight := range tunerWeights {
+			selected := ""
+			if i == m.tuner.selected {
+				selected = " (selected)"
+			}
+			lines = append(lines, fmt.Sprintf("%s: %d%s.", weight.name, *weight.value(&m.tuner.weights), selected))
+		}
+		for i, repo := range m.repos[:min(tunerPreviewRepos, len(m.repos))] {
+			lines = append(lines, fmt.Sprintf("Rank %d: %s, score %d.", i+1, repoKey(repo), repo.RelevanceScore))
+		}
+		lines = append(lines, "Keys: up and down to choose a weight, left and right to adjust it, enter to save, q to cancel.")
+
+	case scanScreen:
+		if m.scan != nil {
+			paused := ""
+			if m.scan.control.Paused() {
+				paused = " Paused."
+			}
+			lines = append(lines, fmt.Sprintf("Scanned %d of %d repositories, found %d issues.%s",
+				m.scan.progress.Scanned, m.scan.progress.Total, m.scan.progress.Found, paused),
+				"Keys: space to pause or resume, enter to browse issues found so far, q to go back while the scan continues.")
+		}
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+// difficultyName returns the plain difficulty band for a score
+func difficultyName(score int) string {
+	return github.DifficultyBand(score)
+}
@@ -0,0 +1,56 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/logger"
+)
+
+// autoRefreshIdle is how long the keyboard must have been idle before the
+// repo list is refreshed automatically, so it never changes under the cursor
+const autoRefreshIdle = 30 * time.Second
+
+// autoRefreshMsg fires every Config.AutoRefreshMinutes
+type autoRefreshMsg struct{}
+
+// scheduleAutoRefresh ticks after Config.AutoRefreshMinutes, or does nothing
+// when auto-refresh is off
+func (m Model) scheduleAutoRefresh() tea.Cmd {
+	if m.config.AutoRefreshMinutes <= 0 {
+		return nil
+	}
+	interval := time.Duration(m.config.AutoRefreshMinutes) * time.Minute
+	return tea.Tick(interval, func(time.Time) tea.Msg { return autoRefreshMsg{} })
+}
+
+// handleAutoRefresh searches again in the background when the repo list is
+// shown and the user has been idle, keeping the selection by identity. Repos
+// the session hasn't listed before are badged as new.
+func (m Model) handleAutoRefresh() (Model, tea.Cmd) {
+	next := m.scheduleAutoRefresh()
+	if m.currentScreen != repoListScreen || m.loading || m.typingFilter() || m.topicFilter != "" || len(m.repos) == 0 ||
+		time.Since(m.lastKeyAt) < autoRefreshIdle {
+		return m, next
+	}
+
+	logger.Info(fmt.Sprintf("Auto-refreshing repositories page %d after %d idle minutes", m.currentPage, m.config.AutoRefreshMinutes))
+	m.github.ClearRepoSearchCache()
+	load := m.loadRepositoriesPage(m.currentPage)
+	refresh := func() tea.Msg {
+		switch msg := load().(type) {
+		case reposLoadedMsg:
+			msg.background = true
+			msg.autoRefresh = true
+			return msg
+		case errorMsg:
+			logger.ErrorWithErr("Auto-refresh failed, keeping the current list", msg.err)
+			return nil
+		default:
+			return msg
+		}
+	}
+	return m, tea.Batch(next, refresh)
+}
@@ -0,0 +1,125 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/bookmarks"
+	"hacktober/internal/logger"
+)
+
+// bookmarkItem is one saved issue on the bookmarks screen
+type bookmarkItem struct {
+	bookmark bookmarks.Bookmark
+}
+
+func (i bookmarkItem) FilterValue() string { return i.bookmark.Title + " " + i.bookmark.Ref() }
+func (i bookmarkItem) Title() string       { return i.bookmark.Title }
+func (i bookmarkItem) Description() string {
+	return fmt.Sprintf("%s • %s", i.bookmark.Ref(), i.bookmark.URL)
+}
+
+type bookmarksLoadedMsg struct {
+	bookmarks []bookmarks.Bookmark
+}
+
+// newBookmarksList creates the list used by the bookmarks screen
+func newBookmarksList() list.Model {
+	bookmarksList := list.New([]list.Item{}, list.NewDefaultDelegate(), 0, 0)
+	bookmarksList.Title = "Bookmarked Issues"
+	bookmarksList.SetShowStatusBar(true)
+	bookmarksList.SetFilteringEnabled(true)
+	bookmarksList.SetShowHelp(true)
+	return bookmarksList
+}
+
+func (m Model) loadBookmarks() tea.Cmd {
+	return func() tea.Msg {
+		saved, err := bookmarks.List()
+		if err != nil {
+			logger.ErrorWithErr("Failed to load bookmarks", err)
+			return errorMsg{err: fmt.Errorf("failed to read bookmarks: %w", err)}
+		}
+		return bookmarksLoadedMsg{bookmarks: saved}
+	}
+}
+
+// showBookmarks fills the bookmarks screen, keeping the selection in range
+// when a bookmark was removed
+func (m Model) showBookmarks(saved []bookmarks.Bookmark) Model {
+	items := make([]list.Item, len(saved))
+	for i, bookmark := range saved {
+		items[i] = bookmarkItem{bookmark: bookmark}
+	}
+	index := m.bookmarksList.Index()
+	m.bookmarksList.SetItems(items)
+	m.bookmarksList.Select(min(index, max(len(items)-1, 0)))
+	return m.enterScreen(bookmarksScreen)
+}
+
+// handleBookmark opens the bookmarks from the welcome screen, removes the
+// selected one on the bookmarks screen, and toggles the bookmark of the open
+// or selected issue on the issue screens
+func (m Model) handleBookmark() (Model, tea.Cmd) {
+	switch m.currentScreen {
+	case welcomeScreen:
+		return m, m.loadBookmarks()
+
+	case bookmarksScreen:
+		item, ok := m.bookmarksList.SelectedItem().(bookmarkItem)
+		if !ok {
+			return m, nil
+		}
+		b := item.bookmark
+		if err := bookmarks.Remove(b.Owner, b.Repo, b.Number); err != nil {
+			logger.ErrorWithErr("Failed to remove bookmark", err)
+			m.status = fmt.Sprintf("Removing bookmark failed: %v", err)
+			return m, nil
+		}
+		m.status = fmt.Sprintf("Removed bookmark %s", b.Ref())
+		return m, m.loadBookmarks()
+	}
+
+	issue := m.completionTarget()
+	if issue == nil {
+		return m, nil
+	}
+	owner, repo, ok := bookmarks.IssueRepo(issue)
+	if !ok {
+		m.status = "Can't bookmark an issue without its repository"
+		return m, nil
+	}
+	ref := fmt.Sprintf("%s/%s#%d", owner, repo, issue.Issue.GetNumber())
+
+	saved, err := bookmarks.Contains(owner, repo, issue.Issue.GetNumber())
+	if err == nil {
+		if saved {
+			err = bookmarks.Remove(owner, repo, issue.Issue.GetNumber())
+		} else {
+			err = bookmarks.Add(issue)
+		}
+	}
+	if err != nil {
+		logger.ErrorWithErr("Failed to update bookmarks", err)
+		m.status = fmt.Sprintf("Bookmarking failed: %v", err)
+		return m, nil
+	}
+
+	if saved {
+		m.status = fmt.Sprintf("Removed bookmark %s", ref)
+	} else {
+		m.status = fmt.Sprintf("Bookmarked %s", ref)
+	}
+	return m, nil
+}
+
+// handleBookmarkSelect opens the selected bookmark in the browser
+func (m Model) handleBookmarkSelect() (Model, tea.Cmd) {
+	item, ok := m.bookmarksList.SelectedItem().(bookmarkItem)
+	if !ok {
+		return m, nil
+	}
+	return m, m.openInBrowser(item.bookmark.URL)
+}
@@ -0,0 +1,85 @@
+package cli
+
+import (
+	"fmt"
+	"io"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/mattn/go-runewidth"
+)
+
+// compactDelegate renders every list item on a single line so many more
+// items fit on screen
+type compactDelegate struct{}
+
+func (d compactDelegate) Height() int                             { return 1 }
+func (d compactDelegate) Spacing() int                            { return 0 }
+func (d compactDelegate) Update(_ tea.Msg, _ *list.Model) tea.Cmd { return nil }
+
+func (d compactDelegate) Render(w io.Writer, m list.Model, index int, item list.Item) {
+	var line string
+	switch i := item.(type) {
+	case repoItem:
+		line = fmt.Sprintf("%s %s%s", i.Title(), icons.Star, formatStars(i.repo))
+		if i.showScores {
+			line += " " + FormatRelevanceScore(i.repo.RelevanceScore)
+		}
+	case sectionItem:
+		fmt.Fprint(w, SubHeaderStyle.Render(i.Title()))
+		return
+	default:
+		if titled, ok := item.(interface{ Title() string }); ok {
+			line = titled.Title()
+		}
+	}
+
+	// Leave room for the selection marker and padding
+	line = runewidth.Truncate(line, max(10, m.Width()-6), "…")
+	if index == m.Index() {
+		fmt.Fprint(w, RenderSelectedItem(line))
+	} else {
+		fmt.Fprint(w, RenderNormalItem(line))
+	}
+}
+
+// repoDelegate returns the repository list delegate for the chosen density
+func repoDelegate(compact bool) list.ItemDelegate {
+	if compact {
+		return compactDelegate{}
+	}
+	delegate := list.NewDefaultDelegate()
+	delegate.SetHeight(4)  // Allow more space for descriptions (title + description lines)
+	delegate.SetSpacing(0) // No extra spacing, let content determine spacing
+	return delegate
+}
+
+// issueDelegate returns the issue list delegate for the chosen density
+func issueDelegate(compact bool) list.ItemDelegate {
+	if compact {
+		return compactDelegate{}
+	}
+	delegate := list.NewDefaultDelegate()
+	delegate.SetHeight(3) // Allow for issue title + details
+	delegate.SetSpacing(0)
+	return delegate
+}
+
+// toggleCompact switches both lists between the regular and the one line
+// per item layout, keeping the selected items
+func (m Model) toggleCompact() Model {
+	m.config.CompactList = !m.config.CompactList
+
+	repoIndex, issueIndex := m.repoList.Index(), m.issueList.Index()
+	m.repoList.SetDelegate(repoDelegate(m.config.CompactList))
+	m.issueList.SetDelegate(issueDelegate(m.config.CompactList))
+	m.repoList.Select(repoIndex)
+	m.issueList.Select(issueIndex)
+
+	if m.config.CompactList {
+		m.status = "Compact list view"
+	} else {
+		m.status = "Detailed list view"
+	}
+	return m
+}
@@ -0,0 +1,216 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+	"hacktober/internal/store"
+)
+
+// newPRInput creates the text input used to paste a pull request URL
+func newPRInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "https://github.com/owner/repo/pull/123 (optional)"
+	input.Prompt = "PR URL: "
+	input.CharLimit = 200
+	return input
+}
+
+// completionTarget returns the issue the complete action applies to: the
+// open issue on the detail screen, or the selected one in the issue list
+func (m Model) completionTarget() *github.Issue {
+	switch m.currentScreen {
+	case issueDetailScreen:
+		return m.selectedIssue
+	case issueListScreen:
+		if item, ok := m.issueList.SelectedItem().(issueItem); ok {
+			return item.issue
+		}
+	}
+	return nil
+}
+
+// handleComplete starts recording a submitted PR for the target issue, or
+// clears the record if the issue is already marked as completed
+func (m Model) handleComplete() (Model, tea.Cmd) {
+	issue := m.completionTarget()
+	if issue == nil {
+		return m, nil
+	}
+	key := issueKey(issue)
+	if _, done := m.store.Completed[key]; done {
+		delete(m.store.Completed, key)
+		m.saveStore("Failed to save completed issues")
+		m.status = fmt.Sprintf("Unmarked %s as completed", key)
+		return m.refreshIssueItem(issue), nil
+	}
+
+	m.prTarget = issue
+	m.prInput = newPRInput()
+	return m, m.prInput.Focus()
+}
+
+// handlePRInput feeds keys to the PR URL prompt: enter records the issue as
+// completed, esc cancels
+func (m Model) handlePRInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.prTarget = nil
+		m.status = "Cancelled marking the issue as completed"
+		return m, nil
+
+	case tea.KeyEnter:
+		issue := m.prTarget
+		m.prTarget = nil
+
+		key := issueKey(issue)
+		m.store.Completed[key] = store.Completion{
+			Title:       issue.Issue.GetTitle(),
+			IssueURL:    issue.Issue.GetHTMLURL(),
+			PRURL:       strings.TrimSpace(m.prInput.Value()),
+			CompletedAt: time.Now(),
+		}
+		m.saveStore("Failed to save completed issues")
+		logger.Info(fmt.Sprintf("Marked %s as completed", key))
+		m.status = fmt.Sprintf("Marked %s as completed", key)
+		m = m.refreshIssueItem(issue)
+		if m.config.AutoAdvanceAfterClaim {
+			m = m.advanceFrom(issue)
+		}
+		return m, nil
+	}
+
+	var cmd tea.Cmd
+	m.prInput, cmd = m.prInput.Update(msg)
+	return m, cmd
+}
+
+// advanceFrom returns to the issue list and selects the next unclaimed issue
+// after the one just marked as completed
+func (m Model) advanceFrom(issue *github.Issue) Model {
+	m.currentScreen = issueListScreen
+
+	items := m.issueList.VisibleItems()
+	from := -1
+	for i, item := range items {
+		if current, ok := item.(issueItem); ok && issueKey(current.issue) == issueKey(issue) {
+			from = i
+			break
+		}
+	}
+
+	next := nextUnclaimedIndex(items, from, m.isCompleted)
+	if next < 0 {
+		m.status += " • no unclaimed issues left"
+		return m
+	}
+	m.issueList.Select(next)
+	m.status += fmt.Sprintf(" • moved on to #%d", items[next].(issueItem).issue.Issue.GetNumber())
+	return m
+}
+
+// nextUnclaimedIndex returns the index of the first open, unassigned and
+// uncompleted issue after from, wrapping around, or -1 if there is none
+func nextUnclaimedIndex(items []list.Item, from int, completed func(*github.Issue) bool) int {
+	for step := 1; step <= len(items); step++ {
+		i := (from + step) % len(items)
+		if item, ok := items[i].(issueItem); ok && claimable(item.issue, completed) {
+			return i
+		}
+	}
+	return -1
+}
+
+// saveStore persists the local store, logging failures
+func (m Model) saveStore(failure string) {
+	if err := m.store.Save(); err != nil {
+		logger.ErrorWithErr(failure, err)
+	}
+}
+
+// isCompleted reports whether the issue has been marked as completed
+func (m Model) isCompleted(issue *github.Issue) bool {
+	_, done := m.store.Completed[issueKey(issue)]
+	return done
+}
+
+// refreshIssueItem redraws the list item for an issue after its completed or
+// snoozed state changed
+func (m Model) refreshIssueItem(issue *github.Issue) Model {
+	key := issueKey(issue)
+	for i, item := range m.issueList.Items() {
+		if current, ok := item.(issueItem); ok && issueKey(current.issue) == key {
+			current.completed = m.isCompleted(issue)
+			current.snoozeBack = m.isBackFromSnooze(issue)
+			m.issueList.SetItem(i, current)
+			break
+		}
+	}
+	return m
+}
+
+// showCompleted switches to the list of completed issues
+func (m Model) showCompleted() Model {
+	m.completedView.SetContent(renderCompleted(m.store.Completed))
+	m.completedView.GotoTop()
+	return m.enterScreen(completedScreen)
+}
+
+// renderCompleted formats completed issues with their PR links, oldest first
+func renderCompleted(completed map[string]store.Completion) string {
+	if len(completed) == 0 {
+		return RenderStatus("No issues marked as completed yet. Press C on an issue after opening a PR.")
+	}
+
+	keys := make([]string, 0, len(completed))
+	for key := range completed {
+		keys = append(keys, key)
+	}
+	sort.Slice(keys, func(i, j int) bool {
+		return completed[keys[i]].CompletedAt.Before(completed[keys[j]].CompletedAt)
+	})
+
+	lines := make([]string, 0, len(keys)*3)
+	for _, key := range keys {
+		completion := completed[key]
+		pr := "no PR link recorded"
+		if completion.PRURL != "" {
+			pr = completion.PRURL
+		}
+		lines = append(lines,
+			fmt.Sprintf("%s %s: %s", icons.Completed, key, completion.Title),
+			fmt.Sprintf("   PR: %s", pr),
+			fmt.Sprintf("   Completed %s • %s", completion.CompletedAt.Format("Jan 2, 2006"), completion.IssueURL),
+		)
+	}
+
+	return strings.Join(lines, "\n")
+}
+
+func (m Model) completedScreenView() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		RenderHeader(fmt.Sprintf("Completed Issues (%d)", len(m.store.Completed))),
+		"",
+		m.completedView.View(),
+		MetaStyle.Render(store.GetStoreLocation()),
+		m.renderFooter(keyHint("Scroll", m.keys.Up, m.keys.Down), keyHint("Back", m.keys.Back)),
+	)
+}
+
+// prPromptView renders the PR URL prompt shown while marking an issue as completed
+func (m Model) prPromptView() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		RenderStatus(fmt.Sprintf("Mark %s as completed", issueKey(m.prTarget))),
+		m.prInput.View(),
+		MetaStyle.Render("Enter: Save • Esc: Cancel"),
+	)
+}
@@ -0,0 +1,80 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+)
+
+// contributionType returns the kind of contribution an issue asks for, such
+// as "docs", "design" or "code", from the first of its labels found in
+// Config.ContributionTypeLabels, or "" if none is
+func (m Model) contributionType(issue *github.Issue) string {
+	for _, label := range issue.Issue.Labels {
+		for name, kind := range m.config.ContributionTypeLabels {
+			if strings.EqualFold(label.GetName(), name) {
+				return strings.ToLower(kind)
+			}
+		}
+	}
+	return ""
+}
+
+// contributionTypes returns the contribution types among the listed issues,
+// before any filter, in name order
+func (m Model) contributionTypes() []string {
+	seen := make(map[string]bool)
+	for _, issue := range m.issues {
+		if kind := m.contributionType(issue); kind != "" {
+			seen[kind] = true
+		}
+	}
+
+	types := make([]string, 0, len(seen))
+	for kind := range seen {
+		types = append(types, kind)
+	}
+	sort.Strings(types)
+	return types
+}
+
+// handleContributionType narrows the issue list to the next contribution
+// type among the listed issues, showing every issue again after the last
+func (m Model) handleContributionType() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen {
+		return m, nil
+	}
+
+	types := m.contributionTypes()
+	if len(types) == 0 {
+		m.status = "None of the listed issues carry a contribution type label"
+		return m, nil
+	}
+
+	next := types[0]
+	for i, kind := range types {
+		if kind == m.typeFilter {
+			next = "" // after the last type, show everything again
+			if i+1 < len(types) {
+				next = types[i+1]
+			}
+			break
+		}
+	}
+	if next == "" {
+		return m.clearIssueFilter(), nil
+	}
+
+	m.typeFilter = next
+	m.labelFilter = ""
+	m.preset = nil
+	m = m.narrowIssues(func(issue *github.Issue) bool {
+		return m.contributionType(issue) == next
+	})
+	m.status = fmt.Sprintf("%d %s issues", len(m.issueList.Items()), next)
+	return m, nil
+}
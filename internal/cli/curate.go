@@ -0,0 +1,147 @@
+package cli
+
+import (
+	"encoding/csv"
+	"errors"
+	"flag"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// CurateOptions describes a curated good first issue handout, see Curate
+type CurateOptions struct {
+	ReposFile string // "owner/name" lines or a go.mod, as for Config.DependencyFile
+	Topic     string // otherwise search hacktoberfest repositories with this topic
+	Out       string // ".csv" writes CSV, anything else markdown; empty writes markdown to stdout
+}
+
+// RunCurate runs "hacktober curate" with its command line arguments, e.g.
+// "--repos-file repos.txt --out issues.md"
+func RunCurate(cfg *config.Config, args []string) error {
+	flags := flag.NewFlagSet("curate", flag.ContinueOnError)
+	var opts CurateOptions
+	flags.StringVar(&opts.ReposFile, "repos-file", "", "file of owner/name repositories, one per line, or a go.mod")
+	flags.StringVar(&opts.Topic, "topic", "", "search hacktoberfest repositories with this topic instead of reading a file")
+	flags.StringVar(&opts.Out, "out", "", "output file, CSV if it ends in .csv and markdown otherwise; stdout if empty")
+	if err := flags.Parse(args); err != nil {
+		return err
+	}
+	return Curate(cfg, opts)
+}
+
+// Curate scans the chosen repositories for good first issues and writes them,
+// with their difficulty and links, as a handout for event attendees
+func Curate(cfg *config.Config, opts CurateOptions) error {
+	if (opts.ReposFile == "") == (opts.Topic == "") {
+		return errors.New("curate needs either --repos-file or --topic")
+	}
+
+	client := github.NewClient(cfg.GitHubToken)
+	applyClientSettings(client, cfg)
+
+	repos, err := curateRepositories(client, cfg, opts)
+	if err != nil {
+		return err
+	}
+	if len(repos) == 0 {
+		return errors.New("no repositories to curate issues from")
+	}
+
+	// Report progress on stderr so the handout can go to stdout
+	progress := make(chan github.ScanProgress)
+	reported := make(chan struct{})
+	go func() {
+		defer close(reported)
+		for p := range progress {
+			fmt.Fprintf(os.Stderr, "Scanned %d/%d repositories, %d issues found\n", p.Scanned, p.Total, p.Found)
+		}
+	}()
+	result := client.ScanGoodFirstIssues(repos, cfg.ScanConcurrency, client.NewScanControl(), progress)
+	<-reported
+	if result.Partial {
+		fmt.Fprintf(os.Stderr, "Scan stopped early: %s\n", result.Note)
+	}
+	logger.Info(fmt.Sprintf("Curated %d issues from %d repositories", len(result.Issues), result.Scanned))
+
+	var out io.Writer = os.Stdout
+	if opts.Out != "" {
+		file, err := os.Create(opts.Out)
+		if err != nil {
+			return fmt.Errorf("failed to create %s: %w", opts.Out, err)
+		}
+		defer file.Close()
+		out = file
+	}
+
+	if strings.EqualFold(filepath.Ext(opts.Out), ".csv") {
+		err = writeIssuesCSV(out, result.Issues)
+	} else {
+		notCompleted := func(*github.Issue) bool { return false }
+		_, err = fmt.Fprintf(out, "# Good first issues\n\n%s", FormatIssueTaskList(result.Issues, notCompleted, "repo"))
+	}
+	if err != nil {
+		return fmt.Errorf("failed to write curated issues: %w", err)
+	}
+	return nil
+}
+
+// curateRepositories returns the repositories named in opts.ReposFile, or
+// found by searching for opts.Topic
+func curateRepositories(client *github.Client, cfg *config.Config, opts CurateOptions) ([]*github.Repository, error) {
+	if opts.Topic != "" {
+		search := github.RepoSearchOptions{MinStars: cfg.MinStars, MaxStars: cfg.MaxStars, Languages: cfg.PreferredLanguages, Topic: opts.Topic}
+		result, err := client.SearchHacktoberfestReposWithPage(search, max(1, cfg.MaxRepos), 1)
+		if err != nil {
+			return nil, err
+		}
+		return result.Repositories, nil
+	}
+
+	names, err := readRepoFile(opts.ReposFile)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read %s: %w", opts.ReposFile, err)
+	}
+	repos := make([]*github.Repository, 0, len(names))
+	for _, name := range names {
+		owner, repo, _ := strings.Cut(name, "/")
+		repos = append(repos, github.RepositoryRef(owner, repo))
+	}
+	return repos, nil
+}
+
+// writeIssuesCSV writes one row per issue with its repository, number,
+// title, difficulty, labels and link
+func writeIssuesCSV(w io.Writer, issues []*github.Issue) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write([]string{"repository", "number", "title", "difficulty", "labels", "url"}); err != nil {
+		return err
+	}
+	for _, issue := range issues {
+		labels := make([]string, 0, len(issue.Issue.Labels))
+		for _, label := range issue.Issue.Labels {
+			labels = append(labels, label.GetName())
+		}
+		repo, _, _ := strings.Cut(issueKey(issue), "#")
+		row := []string{
+			repo,
+			strconv.Itoa(issue.Issue.GetNumber()),
+			issue.Issue.GetTitle(),
+			difficultyName(issue.DifficultyScore),
+			strings.Join(labels, "; "),
+			issue.Issue.GetHTMLURL(),
+		}
+		if err := writer.Write(row); err != nil {
+			return err
+		}
+	}
+	writer.Flush()
+	return writer.Error()
+}
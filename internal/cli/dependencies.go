@@ -0,0 +1,98 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// dependencies returns the "owner/name" repositories the user's projects
+// depend on: Config.DependencyRepos plus those read from Config.DependencyFile
+func (m Model) dependencies() []string {
+	deps := append([]string(nil), m.config.DependencyRepos...)
+	if m.config.DependencyFile == "" {
+		return deps
+	}
+
+	fromFile, err := readRepoFile(m.config.DependencyFile)
+	if err != nil {
+		logger.ErrorWithErr("Failed to read dependency file, using dependency_repos only", err)
+		return deps
+	}
+	return append(deps, fromFile...)
+}
+
+// readRepoFile reads the GitHub repositories a go.mod requires or, for any
+// other file, "owner/name" references one per line. Blank lines and lines
+// starting with # or // are ignored.
+func readRepoFile(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	goMod := filepath.Base(path) == "go.mod"
+
+	var deps []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") || strings.HasPrefix(line, "//") {
+			continue
+		}
+
+		if goMod {
+			if dep, ok := goModDependency(line); ok {
+				deps = append(deps, dep)
+			}
+			continue
+		}
+
+		if _, _, err := github.ParseRepoReference(line); err != nil {
+			logger.Warn(fmt.Sprintf("Ignoring repository file entry: %v", err))
+			continue
+		}
+		deps = append(deps, line)
+	}
+
+	logger.Info(fmt.Sprintf("Read %d repositories from %s", len(deps), path))
+	return deps, scanner.Err()
+}
+
+// goModDependency returns the GitHub repository of a go.mod require line,
+// e.g. "github.com/rs/zerolog v1.34.0 // indirect" gives "rs/zerolog".
+// Modules hosted elsewhere, and module, go and replace lines, give nothing.
+func goModDependency(line string) (string, bool) {
+	fields := strings.Fields(strings.TrimPrefix(line, "require "))
+	if len(fields) < 2 || strings.Contains(line, "=>") {
+		return "", false
+	}
+
+	parts := strings.Split(fields[0], "/")
+	if len(parts) < 3 || parts[0] != "github.com" {
+		return "", false
+	}
+	return parts[1] + "/" + parts[2], true
+}
+
+// familiarRepoBehaviors are the accepted Config.FamiliarRepoBehavior values
+var familiarRepoBehaviors = map[string]bool{"off": true, "boost": true, "flag": true}
+
+// familiarRepoBehavior returns how repositories the user starred or
+// contributed to are treated, "off" when unset or unknown
+func (m Model) familiarRepoBehavior() string {
+	behavior := strings.ToLower(m.config.FamiliarRepoBehavior)
+	if !familiarRepoBehaviors[behavior] {
+		if behavior != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown familiar_repo_behavior %q", m.config.FamiliarRepoBehavior))
+		}
+		return "off"
+	}
+	return behavior
+}
@@ -0,0 +1,208 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// defaultIssueDetailFields is the issue detail layout used when
+// Config.IssueDetailFields is empty
+var defaultIssueDetailFields = []string{
+	"author", "created", "comments", "first_response", "difficulty", "labels", "milestone", "projects", "reactions", "linked_prs", "timeline", "url", "body",
+}
+
+// issueDetailFields are all fields the issue detail screen can show
+var issueDetailFields = map[string]bool{
+	"author": true, "created": true, "updated": true, "comments": true, "first_response": true, "difficulty": true,
+	"labels": true, "assignees": true, "milestone": true, "projects": true, "reactions": true, "linked_prs": true,
+	"timeline": true, "url": true, "body": true,
+}
+
+// validDetailFields returns the configured issue detail fields in order,
+// dropping unknown names with a warning, or the default layout if none are set
+func validDetailFields(fields []string) []string {
+	if len(fields) == 0 {
+		return defaultIssueDetailFields
+	}
+
+	valid := make([]string, 0, len(fields))
+	for _, field := range fields {
+		name := strings.ToLower(strings.TrimSpace(field))
+		if !issueDetailFields[name] {
+			logger.Warn(fmt.Sprintf("Ignoring unknown issue detail field: %s", field))
+			continue
+		}
+		valid = append(valid, name)
+	}
+	return valid
+}
+
+// descriptionWidth returns the width issue descriptions wrap to: the
+// terminal width, or fallbackWidth before the terminal size is known
+func (m Model) descriptionWidth() int {
+	if m.width <= 0 {
+		return fallbackWidth
+	}
+	return m.width
+}
+
+// renderDetailField renders one issue detail field, or nothing if the issue
+// has no data for it
+func (m Model) renderDetailField(field string, issue *github.Issue) []string {
+	switch field {
+	case "author":
+		author := issue.Issue.GetUser().GetLogin()
+		if role := authorRole(issue); m.config.ShowAuthorAssociation && role != "" {
+			author += " (" + role + ")"
+		}
+		return []string{ContentStyle.Render(fmt.Sprintf("Author: %s", author))}
+
+	case "created":
+		return []string{ContentStyle.Render(fmt.Sprintf("Created: %s",
+			issue.Issue.GetCreatedAt().Format("January 2, 2006 at 15:04")))}
+
+	case "updated":
+		return []string{ContentStyle.Render(fmt.Sprintf("Updated: %s",
+			issue.Issue.GetUpdatedAt().Format("January 2, 2006 at 15:04")))}
+
+	case "comments":
+		if issue.Issue.Comments != nil {
+			return []string{ContentStyle.Render(fmt.Sprintf("Comments: %d", *issue.Issue.Comments))}
+		}
+
+	case "first_response":
+		if issue.FirstResponse != nil {
+			return []string{ContentStyle.Render(fmt.Sprintf("First response: %s", formatWait(*issue.FirstResponse)))}
+		}
+		if m.config.ShowFirstResponse {
+			return []string{ContentStyle.Render("First response: none yet")}
+		}
+
+	case "difficulty":
+		difficulty := RenderDifficulty(issue.DifficultyScore)
+		if m.config.ShowScores && issue.DifficultyScore != github.UnknownDifficulty {
+			difficulty += " " + FormatDifficultyScore(issue.DifficultyScore)
+		}
+		return []string{ContentStyle.Render(fmt.Sprintf("Difficulty: %s", difficulty))}
+
+	case "labels":
+		if len(issue.Issue.Labels) > 0 {
+			labels := []string{}
+			for _, label := range issue.Issue.Labels {
+				labels = append(labels, label.GetName())
+			}
+			return []string{ContentStyle.Render(fmt.Sprintf("Labels: %s", RenderLabels(labels, m.config.HighlightLabels)))}
+		}
+
+	case "assignees":
+		if issue.Assigned {
+			assignees := []string{}
+			for _, user := range issue.Issue.Assignees {
+				assignees = append(assignees, user.GetLogin())
+			}
+			return []string{ContentStyle.Render(fmt.Sprintf("Assignees: %s %s assigned", strings.Join(assignees, ", "), icons.Assigned))}
+		}
+		return []string{ContentStyle.Render("Assignees: none")}
+
+	case "milestone":
+		if issue.Issue.Milestone != nil {
+			milestone := issue.Issue.Milestone.GetTitle()
+			if due := issue.Issue.Milestone.DueOn; due != nil {
+				milestone += fmt.Sprintf(" (due %s)", due.Format("January 2, 2006"))
+			}
+			return []string{ContentStyle.Render(fmt.Sprintf("Milestone: %s", milestone))}
+		}
+
+	case "projects":
+		if len(m.projects) > 0 {
+			projects := make([]string, 0, len(m.projects))
+			for _, card := range m.projects {
+				project := card.Project
+				if card.Column != "" {
+					project += " (" + card.Column + ")"
+				}
+				projects = append(projects, project)
+			}
+			return []string{ContentStyle.Render(fmt.Sprintf("Projects: %s", strings.Join(projects, ", ")))}
+		}
+
+	case "reactions":
+		if issue.Issue.Reactions != nil && issue.Issue.Reactions.GetTotalCount() > 0 {
+			reactions := issue.Issue.Reactions
+			return []string{ContentStyle.Render(fmt.Sprintf("Reactions: %s %d • %s %d • %s %d • %s %d • %s %d",
+				icons.PlusOne, reactions.GetPlusOne(), icons.MinusOne, reactions.GetMinusOne(), icons.Heart, reactions.GetHeart(),
+				icons.Hooray, reactions.GetHooray(), icons.Rocket, reactions.GetRocket()))}
+		}
+
+	case "linked_prs":
+		if len(m.linkedPRs) > 0 {
+			lines := []string{ContentStyle.Render(fmt.Sprintf("%s %d linked PRs", icons.LinkedPRs, len(m.linkedPRs)))}
+			for _, pr := range m.linkedPRs {
+				line := fmt.Sprintf("  %s#%d: %s (%s)", pr.Repository, pr.Number, pr.Title, pr.State)
+				if pr.IsOpen() {
+					lines = append(lines, RenderError(line+" - someone may already be working on this"))
+				} else {
+					lines = append(lines, MetaStyle.Render(line))
+				}
+			}
+			return lines
+		}
+
+	case "timeline":
+		if len(m.activity) > 0 {
+			return m.renderTimeline()
+		}
+
+	case "url":
+		return []string{ContentStyle.Render(fmt.Sprintf("URL: %s", issue.Issue.GetHTMLURL()))}
+
+	case "body":
+		if body := issue.Issue.GetBody(); body != "" {
+			if len(body) > 500 {
+				body = body[:500] + "..."
+			}
+			lines := []string{"", RenderSubHeader("Description"), DescriptionStyle.Width(m.descriptionWidth()).Render(body)}
+			if issue.BodyTruncated {
+				lines = append(lines, ContentStyle.Render(MetaStyle.Render("Body truncated, open in browser for full text")))
+			}
+			return lines
+		}
+	}
+
+	return nil
+}
+
+// timelineDetailEvents is how many of the latest timeline events the issue
+// detail screen lists
+const timelineDetailEvents = 6
+
+// renderTimeline renders the latest timeline activity of the selected issue,
+// led by what the most recent signal says about its availability
+func (m Model) renderTimeline() []string {
+	lines := []string{ContentStyle.Render("Timeline:")}
+	if latest, ok := github.LatestSignal(m.activity); ok {
+		switch latest.Signal {
+		case github.SignalTaken:
+			lines = append(lines, RenderError("  Looks taken: "+timelineLine(latest)))
+		case github.SignalAbandoned:
+			lines = append(lines, SuccessStyle.Render("  Looks available again: "+timelineLine(latest)))
+		}
+	}
+
+	events := m.activity[max(0, len(m.activity)-timelineDetailEvents):]
+	for _, event := range events {
+		lines = append(lines, MetaStyle.Render("  "+timelineLine(event)))
+	}
+	if hidden := len(m.activity) - len(events); hidden > 0 {
+		lines = append(lines, MetaStyle.Render(fmt.Sprintf("  ... and %d earlier events", hidden)))
+	}
+	return lines
+}
+
+// timelineLine renders one timeline event as "Jan 2  actor: summary"
+func timelineLine(event github.TimelineEvent) string {
+	return fmt.Sprintf("%s  %s: %s", event.At.Format("Jan 2"), event.Actor, event.Summary)
+}
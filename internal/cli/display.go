@@ -5,6 +5,7 @@ import (
 	"os"
 	"strings"
 
+	"github.com/mattn/go-runewidth"
 	"golang.org/x/term"
 )
 
@@ -144,9 +145,10 @@ func (d *Display) SetColor(color string) {
 	}
 }
 
-// PrintLine prints a line with word wrapping
+// PrintLine prints a line with word wrapping based on display columns, so
+// emoji and wide characters are measured by how much space they take up
 func (d *Display) PrintLine(text string, maxWidth int) {
-	if len(text) <= maxWidth {
+	if runewidth.StringWidth(text) <= maxWidth {
 		fmt.Println(text)
 		return
 	}
@@ -155,7 +157,7 @@ func (d *Display) PrintLine(text string, maxWidth int) {
 	line := ""
 
 	for _, word := range words {
-		if len(line)+len(word)+1 > maxWidth {
+		if runewidth.StringWidth(line)+runewidth.StringWidth(word)+1 > maxWidth {
 			fmt.Println(line)
 			line = word
 		} else {
@@ -177,7 +179,7 @@ func (d *Display) PrintHeader(title string) {
 	d.SetColor("bold")
 	d.SetColor("cyan")
 	fmt.Printf("═══ %s ", title)
-	for i := len(title) + 5; i < d.width; i++ {
+	for i := runewidth.StringWidth(title) + 5; i < d.width; i++ {
 		fmt.Print("═")
 	}
 	fmt.Println()
@@ -189,7 +191,7 @@ func (d *Display) PrintSubheader(title string) {
 	d.SetColor("bold")
 	d.SetColor("yellow")
 	fmt.Printf("── %s ", title)
-	for i := len(title) + 4; i < d.width-5; i++ {
+	for i := runewidth.StringWidth(title) + 4; i < d.width-5; i++ {
 		fmt.Print("─")
 	}
 	fmt.Println()
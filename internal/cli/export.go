@@ -0,0 +1,258 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/bookmarks"
+	"hacktober/internal/export"
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// taskListEntry is one line of a markdown task list
+type taskListEntry struct {
+	ref        string // owner/repo#123, which GitHub auto-links
+	title      string
+	difficulty string // band name, empty if unknown
+	url        string
+	done       bool
+}
+
+// FormatIssueTaskList formats issues as a GitHub markdown task list that
+// auto-links when pasted into an issue body, e.g.
+// "- [ ] owner/repo#123 Title (Easy) — url". Completed issues are checked.
+// groupBy is "difficulty" or "repo"; anything else keeps a single flat list.
+func FormatIssueTaskList(issues []*github.Issue, completed func(*github.Issue) bool, groupBy string) string {
+	entries := make([]taskListEntry, len(issues))
+	for i, issue := range issues {
+		entries[i] = taskListEntry{
+			ref:        issueKey(issue),
+			title:      issue.Issue.GetTitle(),
+			difficulty: difficultyName(issue.DifficultyScore),
+			url:        issue.Issue.GetHTMLURL(),
+			done:       completed(issue),
+		}
+	}
+	return formatTaskList(entries, groupBy)
+}
+
+// FormatBookmarksTaskList formats bookmarks as a task list like
+// FormatIssueTaskList. completed is asked about each bookmark's
+// owner/repo#number; bookmarks saved without a difficulty group as Unknown.
+func FormatBookmarksTaskList(saved []bookmarks.Bookmark, completed func(ref string) bool, groupBy string) string {
+	entries := make([]taskListEntry, len(saved))
+	for i, bookmark := range saved {
+		entries[i] = taskListEntry{
+			ref:        bookmark.Ref(),
+			title:      bookmark.Title,
+			difficulty: bookmark.Difficulty,
+			url:        bookmark.URL,
+			done:       completed(bookmark.Ref()),
+		}
+	}
+	return formatTaskList(entries, groupBy)
+}
+
+// formatTaskList renders task list entries, grouped as FormatIssueTaskList
+// describes
+func formatTaskList(entries []taskListEntry, groupBy string) string {
+	groupOf := func(taskListEntry) string { return "" }
+	switch groupBy {
+	case "difficulty":
+		groupOf = func(entry taskListEntry) string {
+			if entry.difficulty == "" {
+				return "Unknown"
+			}
+			return entry.difficulty
+		}
+	case "repo":
+		groupOf = func(entry taskListEntry) string {
+			repo, _, _ := strings.Cut(entry.ref, "#")
+			return repo
+		}
+	}
+
+	sorted := append([]taskListEntry(nil), entries...)
+	if groupBy == "difficulty" {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return bandRank(groupOf(sorted[i])) < bandRank(groupOf(sorted[j]))
+		})
+	} else {
+		sort.SliceStable(sorted, func(i, j int) bool {
+			return groupOf(sorted[i]) < groupOf(sorted[j])
+		})
+	}
+
+	var lines []string
+	group := ""
+	for i, entry := range sorted {
+		if name := groupOf(entry); name != "" && (i == 0 || name != group) {
+			if len(lines) > 0 {
+				lines = append(lines, "")
+			}
+			lines = append(lines, "### "+name)
+			group = name
+		}
+
+		box := "[ ]"
+		if entry.done {
+			box = "[x]"
+		}
+		line := fmt.Sprintf("- %s %s %s", box, entry.ref, entry.title)
+		if entry.difficulty != "" {
+			line += " (" + entry.difficulty + ")"
+		}
+		lines = append(lines, line+" — "+entry.url)
+	}
+
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// taskListPath returns where the markdown task list export is written
+func taskListPath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "tasklist.md")
+}
+
+// bookmarksTaskListPath returns where the bookmarks task list export is
+// written
+func bookmarksTaskListPath() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "bookmarks.md")
+}
+
+// writeTaskList writes a markdown task list to path, creating its directory
+func writeTaskList(path, markdown string) error {
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+	return os.WriteFile(path, []byte(markdown), 0644)
+}
+
+// exportPrompt asks which format to export the issue list in
+const exportPrompt = "Export issues as: t task list, j JSON, m markdown table (any other key cancels)"
+
+// handleExport asks which format to export the current issue list in,
+// writes the repositories on the current page as CSV, or writes the
+// bookmarks as a task list
+func (m Model) handleExport() (Model, tea.Cmd) {
+	switch m.currentScreen {
+	case repoListScreen:
+		return m.handleExportRepos()
+	case bookmarksScreen:
+		return m.exportBookmarks()
+	}
+	if m.currentScreen != issueListScreen || len(m.issues) == 0 {
+		return m, nil
+	}
+
+	m.confirmExport = true
+	return m, nil
+}
+
+// handleExportChoice resolves a pending issue export: "t" writes the
+// markdown task list, "j" JSON and "m" a markdown table, quit exits and any
+// other key cancels
+func (m Model) handleExportChoice(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.confirmExport = false
+	if key.Matches(msg, m.keys.Quit) {
+		return m.quit()
+	}
+
+	var path string
+	var err error
+	switch msg.String() {
+	case "t":
+		return m.exportTaskList()
+	case "j":
+		path, err = export.WriteIssuesJSON(m.selectedRepo, m.issues)
+	case "m":
+		path, err = export.WriteIssuesMarkdown(m.selectedRepo, m.issues)
+	default:
+		m.status = "Export cancelled"
+		return m, nil
+	}
+	if err != nil {
+		logger.ErrorWithErr("Failed to export issues", err)
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m, nil
+	}
+
+	logger.Info(fmt.Sprintf("Exported %d issues to %s", len(m.issues), path))
+	m.status = fmt.Sprintf("Exported %d issues to %s", len(m.issues), path)
+	return m, nil
+}
+
+// exportTaskList writes the current issue list as a markdown task list
+func (m Model) exportTaskList() (Model, tea.Cmd) {
+	markdown := FormatIssueTaskList(m.issues, m.isCompleted, m.config.TaskListGroupBy)
+
+	path := taskListPath()
+	if err := writeTaskList(path, markdown); err != nil {
+		logger.ErrorWithErr("Failed to export task list", err)
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m, nil
+	}
+
+	logger.Info(fmt.Sprintf("Exported %d issues to %s", len(m.issues), path))
+	m.status = fmt.Sprintf("Exported %d issues as a task list to %s", len(m.issues), path)
+	return m, nil
+}
+
+// exportBookmarks writes every bookmark as a markdown task list, checking
+// the ones marked as completed
+func (m Model) exportBookmarks() (Model, tea.Cmd) {
+	saved, err := bookmarks.List()
+	if err != nil {
+		logger.ErrorWithErr("Failed to load bookmarks", err)
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m, nil
+	}
+	if len(saved) == 0 {
+		m.status = "No bookmarks to export"
+		return m, nil
+	}
+
+	completed := func(ref string) bool {
+		_, done := m.store.Completed[ref]
+		return done
+	}
+	markdown := FormatBookmarksTaskList(saved, completed, m.config.TaskListGroupBy)
+
+	path := bookmarksTaskListPath()
+	if err := writeTaskList(path, markdown); err != nil {
+		logger.ErrorWithErr("Failed to export bookmarks", err)
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m, nil
+	}
+
+	logger.Info(fmt.Sprintf("Exported %d bookmarks to %s", len(saved), path))
+	m.status = fmt.Sprintf("Exported %d bookmarks as a task list to %s", len(saved), path)
+	return m, nil
+}
+
+// handleExportRepos writes the repositories on the current page to a new
+// timestamped CSV file
+func (m Model) handleExportRepos() (Model, tea.Cmd) {
+	if len(m.repos) == 0 {
+		return m, nil
+	}
+
+	path, err := export.WriteReposCSV(m.repos)
+	if err != nil {
+		logger.ErrorWithErr("Failed to export repositories", err)
+		m.status = fmt.Sprintf("Export failed: %v", err)
+		return m, nil
+	}
+
+	logger.Info(fmt.Sprintf("Exported %d repositories to %s", len(m.repos), path))
+	m.status = fmt.Sprintf("Exported %d repositories to %s", len(m.repos), path)
+	return m, nil
+}
@@ -0,0 +1,60 @@
+package cli
+
+import (
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/key"
+)
+
+// keyNames spells out keys whose binding names don't read well in a footer
+var keyNames = map[string]string{
+	"up":    "↑",
+	"down":  "↓",
+	"left":  "←",
+	"right": "→",
+	" ":     "Space",
+}
+
+// keyName renders a bubbletea key name the way footers show it: arrows as
+// symbols, letters uppercase and capital letters as Shift+letter
+func keyName(k string) string {
+	if name, ok := keyNames[k]; ok {
+		return name
+	}
+	if r := []rune(k); len(r) == 1 {
+		if unicode.IsUpper(r[0]) {
+			return "Shift+" + k
+		}
+		return strings.ToUpper(k)
+	}
+
+	parts := strings.Split(k, "+")
+	for i, part := range parts {
+		if part != "" {
+			parts[i] = strings.ToUpper(part[:1]) + part[1:]
+		}
+	}
+	return strings.Join(parts, "+")
+}
+
+// keyHint describes what bindings do on the current screen, naming each
+// binding by its primary key so the hint follows any remapping
+func keyHint(action string, bindings ...key.Binding) string {
+	var names []string
+	for _, binding := range bindings {
+		if keys := binding.Keys(); len(keys) > 0 && binding.Enabled() {
+			names = append(names, keyName(keys[0]))
+		}
+	}
+	return strings.Join(names, "/") + ": " + action
+}
+
+// renderFooter joins key hints into a screen footer, or returns nothing when
+// Config.ShowFooter is off
+func (m Model) renderFooter(hints ...string) string {
+	if !m.config.ShowFooter {
+		return ""
+	}
+	return FooterStyle.Render(strings.Join(hints, " • "))
+}
@@ -0,0 +1,138 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/config"
+	"hacktober/internal/history"
+	"hacktober/internal/logger"
+)
+
+// historyItem is one past search on the history screen
+type historyItem struct {
+	summary history.SearchSummary
+}
+
+func (i historyItem) FilterValue() string { return strings.Join(i.summary.Search.Languages, " ") }
+func (i historyItem) Title() string       { return historyLine(i.summary) }
+func (i historyItem) Description() string { return "" }
+
+// newHistoryList creates the list used by the history screen
+func newHistoryList() list.Model {
+	historyList := list.New([]list.Item{}, compactDelegate{}, 0, 0)
+	historyList.Title = "Search History"
+	historyList.SetShowStatusBar(true)
+	historyList.SetFilteringEnabled(true)
+	historyList.SetShowHelp(true)
+	return historyList
+}
+
+// historyItems lists the searches oldest first, as they were recorded
+func historyItems(summaries []history.SearchSummary) []list.Item {
+	items := make([]list.Item, len(summaries))
+	for i, summary := range summaries {
+		items[i] = historyItem{summary: summary}
+	}
+	return items
+}
+
+// historyLine describes a past search and what came of it on one line
+func historyLine(summary history.SearchSummary) string {
+	search := summary.Search
+	languages := "any language"
+	if len(search.Languages) > 0 {
+		languages = strings.Join(search.Languages, ", ")
+	}
+
+	parts := []string{search.Time.Format("Jan 2, 2006 15:04"), languages, starRange(search.MinStars, search.MaxStars) + " stars"}
+	if search.MinOpenIssues > 0 {
+		parts = append(parts, fmt.Sprintf("≥%d open issues", search.MinOpenIssues))
+	}
+	if search.OwnerType != "" {
+		parts = append(parts, search.OwnerType+" owners")
+	}
+	if search.IncludeArchived {
+		parts = append(parts, "with archived")
+	}
+	if search.Preset != "" {
+		parts = append(parts, fmt.Sprintf("preset %q", search.Preset))
+	}
+	if search.Page > 1 {
+		parts = append(parts, fmt.Sprintf("page %d", search.Page))
+	}
+	parts = append(parts,
+		fmt.Sprintf("%d results", search.ResultCount),
+		fmt.Sprintf("%d issues opened", summary.IssuesOpened))
+	return strings.Join(parts, " • ")
+}
+
+// searchEntry records the current search criteria for the history file
+func (m Model) searchEntry(resultCount, page int) history.Entry {
+	opts := m.repoSearchOptions()
+	entry := history.Entry{
+		Event:           history.EventSearch,
+		Languages:       opts.Languages,
+		MinStars:        opts.MinStars,
+		MaxStars:        opts.MaxStars,
+		MinOpenIssues:   opts.MinOpenIssues,
+		OwnerType:       opts.OwnerType,
+		IncludeArchived: opts.IncludeArchived,
+		Page:            page,
+		ResultCount:     resultCount,
+	}
+	if m.preset != nil {
+		entry.Preset = m.preset.Name
+	}
+	return entry
+}
+
+// handleHistorySelect re-runs the selected past search with the criteria it
+// was recorded with, which stay in effect for the rest of the session
+func (m Model) handleHistorySelect() (Model, tea.Cmd) {
+	item, ok := m.historyList.SelectedItem().(historyItem)
+	if !ok {
+		return m, nil
+	}
+	search := item.summary.Search
+	logger.Info(fmt.Sprintf("Re-running search from %s: %+v", search.Time.Format("2006-01-02 15:04"), search))
+
+	m.criteria = &searchCriteria{
+		languages:       search.Languages,
+		minStars:        search.MinStars,
+		maxStars:        search.MaxStars,
+		minOpenIssues:   search.MinOpenIssues,
+		ownerType:       search.OwnerType,
+		includeArchived: search.IncludeArchived,
+	}
+
+	m.preset = nil
+	if search.Preset != "" {
+		if preset := presetNamed(m.config.FilterPresets, search.Preset); preset != nil {
+			m.preset = preset
+		} else {
+			logger.Warn(fmt.Sprintf("Filter preset %q from the search history no longer exists", search.Preset))
+		}
+	}
+
+	m.loading = true
+	m.relaxSteps = nil
+	m.relaxExhausted = false
+	m.relaxed = nil
+	m.pendingSearch = true
+	m.github.ClearRepoSearchCache()
+	return m, m.loadRepositoriesPage(max(1, search.Page))
+}
+
+// presetNamed returns a copy of the preset with the given name, or nil
+func presetNamed(presets []config.FilterPreset, name string) *config.FilterPreset {
+	for _, preset := range presets {
+		if preset.Name == name {
+			return &preset
+		}
+	}
+	return nil
+}
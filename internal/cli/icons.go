@@ -0,0 +1,87 @@
+package cli
+
+// iconSet holds the emoji used across the UI, so terminals and fonts without
+// emoji support can fall back to plain ASCII
+type iconSet struct {
+	Header      string
+	Star        string
+	Comment     string
+	Description string
+	Org         string
+	User        string
+	Pinned      string
+	Broadened   string
+	Dependency  string
+	Starred     string
+	Contributed string
+	Completed   string
+	Snoozed     string
+	Warning     string
+	Assigned    string
+	LinkedPRs   string
+	PlusOne     string
+	MinusOne    string
+	Heart       string
+	Hooray      string
+	Rocket      string
+}
+
+var emojiIcons = iconSet{
+	Header:      "🎃",
+	Star:        "⭐",
+	Comment:     "💬",
+	Description: "📝",
+	Org:         "🏢",
+	User:        "👤",
+	Pinned:      "📌",
+	Broadened:   "🔍",
+	Dependency:  "📦",
+	Starred:     "🌟",
+	Contributed: "✓",
+	Completed:   "✅",
+	Snoozed:     "⏰",
+	Warning:     "⚠",
+	Assigned:    "🔒",
+	LinkedPRs:   "🔗",
+	PlusOne:     "👍",
+	MinusOne:    "👎",
+	Heart:       "❤️",
+	Hooray:      "🎉",
+	Rocket:      "🚀",
+}
+
+var asciiIcons = iconSet{
+	Header:      ">>",
+	Star:        "*",
+	Comment:     "#",
+	Description: "-",
+	Org:         "[org]",
+	User:        "[user]",
+	Pinned:      "[pinned]",
+	Broadened:   "[+]",
+	Dependency:  "[dep]",
+	Starred:     "[starred]",
+	Contributed: "[contributor]",
+	Completed:   "[x]",
+	Snoozed:     "[back]",
+	Warning:     "!",
+	Assigned:    "[locked]",
+	LinkedPRs:   "->",
+	PlusOne:     "+1",
+	MinusOne:    "-1",
+	Heart:       "<3",
+	Hooray:      "hooray",
+	Rocket:      "rocket",
+}
+
+// icons is the active icon set, see setIcons
+var icons = emojiIcons
+
+// setIcons switches between emoji and their ASCII equivalents
+func setIcons(useEmoji bool) {
+	if useEmoji {
+		icons = emojiIcons
+	} else {
+		icons = asciiIcons
+	}
+}
@@ -0,0 +1,377 @@
+package cli
+
+import (
+	"fmt"
+	"math"
+	"sort"
+	"strings"
+	"unicode"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+)
+
+// issueKey returns the "owner/name#number" identity of an issue
+func issueKey(issue *github.Issue) string {
+	repo := ""
+	switch {
+	case issue.Repository != nil:
+		repo = repoKey(issue.Repository)
+	case issue.Issue.GetRepositoryURL() != "":
+		_, repo, _ = strings.Cut(issue.Issue.GetRepositoryURL(), "/repos/")
+	default:
+		repo = issue.Issue.GetHTMLURL()
+	}
+	return fmt.Sprintf("%s#%d", repo, issue.Issue.GetNumber())
+}
+
+// mergeIssues merges incoming issues into existing ones, deduplicating by
+// owner/name#number. Existing order is kept, new issues are appended, and
+// when an issue appears more than once the most recently updated version wins.
+func mergeIssues(existing, incoming []*github.Issue) []*github.Issue {
+	merged := make([]*github.Issue, 0, len(existing)+len(incoming))
+	positions := make(map[string]int, len(existing)+len(incoming))
+
+	for _, issue := range append(append([]*github.Issue(nil), existing...), incoming...) {
+		key := issueKey(issue)
+		pos, seen := positions[key]
+		if !seen {
+			positions[key] = len(merged)
+			merged = append(merged, issue)
+			continue
+		}
+		if !issue.Issue.GetUpdatedAt().Before(merged[pos].Issue.GetUpdatedAt().Time) {
+			merged[pos] = issue
+		}
+	}
+
+	return merged
+}
+
+// countLabels counts how many issues carry each (lowercased) label
+func countLabels(issues []*github.Issue) map[string]int {
+	counts := make(map[string]int)
+	for _, issue := range issues {
+		for _, label := range issue.Issue.Labels {
+			counts[strings.ToLower(label.GetName())]++
+		}
+	}
+	return counts
+}
+
+// difficultyBands lists the difficulty band names from easiest to hardest,
+// followed by issues of unknown difficulty
+var difficultyBands = []string{"Easy", "Medium", "Hard", "Expert", "Unknown"}
+
+// difficultyRank returns the position of a score's band in difficultyBands
+func difficultyRank(score int) int {
+	return bandRank(difficultyName(score))
+}
+
+// bandRank returns the position of a band name in difficultyBands
+func bandRank(name string) int {
+	for i, band := range difficultyBands {
+		if band == name {
+			return i
+		}
+	}
+	return len(difficultyBands)
+}
+
+// hideUnknownDifficulty drops issues of unknown difficulty when
+// Config.UnlabeledDifficulty is "hide", returning the rest and how many were
+// hidden
+func (m Model) hideUnknownDifficulty(issues []*github.Issue) ([]*github.Issue, int) {
+	if unlabeledDifficulty(m.config) != "hide" {
+		return issues, 0
+	}
+	shown := make([]*github.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue.DifficultyScore != github.UnknownDifficulty {
+			shown = append(shown, issue)
+		}
+	}
+	return shown, len(issues) - len(shown)
+}
+
+// maintainerAssociations are the author associations of people who can
+// merge into the repository
+var maintainerAssociations = map[string]bool{"OWNER": true, "MEMBER": true, "COLLABORATOR": true}
+
+// authorRole describes who filed an issue from its author association:
+// "maintainer", "contributor" for someone with merged work in the
+// repository, "community" for anyone else, or nothing if GitHub didn't say
+func authorRole(issue *github.Issue) string {
+	association := issue.Issue.GetAuthorAssociation()
+	switch {
+	case association == "":
+		return ""
+	case maintainerAssociations[association]:
+		return "maintainer"
+	case association == "CONTRIBUTOR":
+		return "contributor"
+	default:
+		return "community"
+	}
+}
+
+// byMaintainer reports whether an issue was filed by a maintainer, whose
+// issues tend to be well scoped and welcome
+func byMaintainer(issue *github.Issue) bool {
+	return authorRole(issue) == "maintainer"
+}
+
+// sectionItem is a non-selectable header separating groups in a list
+type sectionItem struct {
+	title string
+	count int
+}
+
+// FilterValue is empty so section headers drop out while filtering
+func (s sectionItem) FilterValue() string {
+	return ""
+}
+
+func (s sectionItem) Title() string {
+	return fmt.Sprintf("── %s (%d) ──", s.title, s.count)
+}
+
+func (s sectionItem) Description() string {
+	return ""
+}
+
+// issueListItems converts issues to list items. When grouped, issues are
+// ordered by difficulty band, keeping their existing order within a band,
+// and each band is preceded by a section header.
+func issueListItems(issues []*github.Issue, newItem func(*github.Issue) issueItem, grouped bool) []list.Item {
+	if !grouped {
+		items := make([]list.Item, len(issues))
+		for i, issue := range issues {
+			items[i] = newItem(issue)
+		}
+		return items
+	}
+
+	sorted := append([]*github.Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return difficultyRank(sorted[i].DifficultyScore) < difficultyRank(sorted[j].DifficultyScore)
+	})
+
+	counts := make(map[string]int)
+	for _, issue := range sorted {
+		counts[difficultyName(issue.DifficultyScore)]++
+	}
+
+	items := make([]list.Item, 0, len(sorted)+len(counts))
+	band := ""
+	for _, issue := range sorted {
+		if name := difficultyName(issue.DifficultyScore); name != band {
+			band = name
+			items = append(items, sectionItem{title: band, count: counts[band]})
+		}
+		items = append(items, newItem(issue))
+	}
+	return items
+}
+
+// indexOfIssueItem returns the list index of the issue with the given
+// number, or -1
+func indexOfIssueItem(items []list.Item, number int) int {
+	if number == 0 {
+		return -1
+	}
+	for i, item := range items {
+		if issue, ok := item.(issueItem); ok && issue.issue.Issue.GetNumber() == number {
+			return i
+		}
+	}
+	return -1
+}
+
+// skipSectionHeader moves the cursor off a section header, continuing in the
+// direction it was travelling from prevIndex
+func skipSectionHeader(l *list.Model, prevIndex int) {
+	if _, ok := l.SelectedItem().(sectionItem); !ok {
+		return
+	}
+	if l.Index() < prevIndex && l.Index() > 0 {
+		l.CursorUp()
+	} else if l.Index() < len(l.VisibleItems())-1 {
+		l.CursorDown()
+	}
+}
+
+// similarTitleThreshold is the share of title words two issues must have in
+// common to be flagged as likely duplicates
+const similarTitleThreshold = 0.6
+
+// titleStopWords are ignored when comparing issue titles
+var titleStopWords = map[string]bool{
+	"a": true, "an": true, "and": true, "the": true, "to": true, "of": true,
+	"in": true, "on": true, "for": true, "with": true, "is": true, "be": true,
+}
+
+// titleTokens returns the distinct normalized words of an issue title
+func titleTokens(title string) map[string]bool {
+	words := strings.FieldsFunc(strings.ToLower(title), func(r rune) bool {
+		return !unicode.IsLetter(r) && !unicode.IsDigit(r)
+	})
+
+	tokens := make(map[string]bool, len(words))
+	for _, word := range words {
+		if !titleStopWords[word] {
+			tokens[word] = true
+		}
+	}
+	return tokens
+}
+
+// titleSimilarity returns the Jaccard similarity of two token sets
+func titleSimilarity(a, b map[string]bool) float64 {
+	if len(a) == 0 || len(b) == 0 {
+		return 0
+	}
+
+	shared := 0
+	for token := range a {
+		if b[token] {
+			shared++
+		}
+	}
+	return float64(shared) / float64(len(a)+len(b)-shared)
+}
+
+// similarIssues maps each issue whose title closely matches another issue in
+// the same repository to the number of the most similar one
+func similarIssues(issues []*github.Issue) map[*github.Issue]int {
+	tokens := make([]map[string]bool, len(issues))
+	repos := make([]string, len(issues))
+	for i, issue := range issues {
+		tokens[i] = titleTokens(issue.Issue.GetTitle())
+		repos[i], _, _ = strings.Cut(issueKey(issue), "#")
+	}
+
+	similar := make(map[*github.Issue]int)
+	for i, issue := range issues {
+		best := 0.0
+		for j, other := range issues {
+			if i == j || repos[i] != repos[j] {
+				continue
+			}
+			// Single-word titles match too easily to be meaningful
+			if len(tokens[i]) < 2 || len(tokens[j]) < 2 {
+				continue
+			}
+			if score := titleSimilarity(tokens[i], tokens[j]); score >= similarTitleThreshold && score > best {
+				best = score
+				similar[issue] = other.Issue.GetNumber()
+			}
+		}
+	}
+	return similar
+}
+
+// skillTargets is the difficulty score that suits each skill level best;
+// beginners simply get the easiest issue
+var skillTargets = map[string]int{"beginner": 0, "intermediate": 45, "advanced": 70}
+
+// skillLevel returns a skill level in lowercase, or intermediate for unknown
+// levels
+func skillLevel(level string) string {
+	level = strings.ToLower(level)
+	if _, ok := skillTargets[level]; !ok {
+		return "intermediate"
+	}
+	return level
+}
+
+// skillTarget returns the difficulty target of a skill level, that of
+// intermediate for unknown levels
+func skillTarget(level string) int {
+	return skillTargets[skillLevel(level)]
+}
+
+// FilterIssuesBySkill returns the issues stable-sorted by how close their
+// difficulty is to the target of the skill level, so beginners see the
+// easiest issues first and advanced users the harder ones. Issues of unknown
+// difficulty go last.
+func FilterIssuesBySkill(issues []*github.Issue, level string) []*github.Issue {
+	target := skillTarget(level)
+	distance := func(issue *github.Issue) int {
+		if issue.DifficultyScore == github.UnknownDifficulty {
+			return math.MaxInt
+		}
+		if issue.DifficultyScore < target {
+			return target - issue.DifficultyScore
+		}
+		return issue.DifficultyScore - target
+	}
+
+	sorted := append([]*github.Issue(nil), issues...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		return distance(sorted[i]) < distance(sorted[j])
+	})
+	return sorted
+}
+
+// claimable reports whether an issue is still up for grabs: open, unassigned
+// and not marked as completed. Every issue picker draws from these.
+func claimable(issue *github.Issue, completed func(*github.Issue) bool) bool {
+	return len(issue.Issue.Assignees) == 0 && issue.Issue.GetState() != "closed" && !completed(issue)
+}
+
+// bestIssueIndex returns the index among items of the unclaimed, not yet
+// completed issue of known difficulty closest to the difficulty target, preferring fewer
+// comments on ties, or -1 if there is none
+func bestIssueIndex(items []list.Item, target int, completed func(*github.Issue) bool) int {
+	best, bestDistance, bestComments := -1, 0, 0
+	for i, item := range items {
+		issueItem, ok := item.(issueItem)
+		if !ok {
+			continue
+		}
+		issue := issueItem.issue
+		if !claimable(issue, completed) || issue.DifficultyScore == github.UnknownDifficulty {
+			continue
+		}
+
+		distance := issue.DifficultyScore - target
+		if distance < 0 {
+			distance = -distance
+		}
+		comments := issue.Issue.GetComments()
+		if best < 0 || distance < bestDistance || (distance == bestDistance && comments < bestComments) {
+			best, bestDistance, bestComments = i, distance, comments
+		}
+	}
+	return best
+}
+
+// handleJumpToBest selects the best issue for the configured skill level
+// among the issues currently shown, explaining the choice in the status line
+func (m Model) handleJumpToBest() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen {
+		return m, nil
+	}
+
+	skill := skillLevel(m.config.SkillLevel)
+	target := skillTarget(skill)
+
+	idx := bestIssueIndex(m.issueList.VisibleItems(), target, m.isCompleted)
+	if idx < 0 {
+		m.status = "No unassigned issue left to suggest"
+		return m, nil
+	}
+	m.issueList.Select(idx)
+
+	issue := m.issueList.VisibleItems()[idx].(issueItem).issue
+	reason := fmt.Sprintf("closest to your %s skill level", skill)
+	if target == 0 {
+		reason = "the easiest for a beginner"
+	}
+	m.status = fmt.Sprintf("Picked #%d: %s (%d), %s, unassigned", issue.Issue.GetNumber(),
+		difficultyName(issue.DifficultyScore), issue.DifficultyScore, reason)
+	return m, nil
+}
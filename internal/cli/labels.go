@@ -0,0 +1,176 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// labelItem is one label with the number of listed issues carrying it
+type labelItem struct {
+	name  string
+	count int
+}
+
+func (i labelItem) FilterValue() string { return i.name }
+func (i labelItem) Title() string       { return fmt.Sprintf("%s (%d)", i.name, i.count) }
+func (i labelItem) Description() string { return "" }
+
+// labelStatsOrders are the accepted Config.LabelStatsSort values
+var labelStatsOrders = map[string]bool{"count": true, "alpha": true, "rare": true}
+
+// sortLabelStats orders label counts for display: "count" puts the most used
+// labels first, "rare" the least used and "alpha" sorts by name. Ties are
+// broken by name, and unknown orders fall back to "count".
+func sortLabelStats(stats map[string]int, order string) []labelItem {
+	order = strings.ToLower(order)
+	if !labelStatsOrders[order] {
+		if order != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown label stats sort %q, using count", order))
+		}
+		order = "count"
+	}
+
+	labels := make([]labelItem, 0, len(stats))
+	for name, count := range stats {
+		labels = append(labels, labelItem{name: name, count: count})
+	}
+	sort.Slice(labels, func(i, j int) bool {
+		a, b := labels[i], labels[j]
+		switch {
+		case order == "count" && a.count != b.count:
+			return a.count > b.count
+		case order == "rare" && a.count != b.count:
+			return a.count < b.count
+		}
+		return a.name < b.name
+	})
+	return labels
+}
+
+// labelStatsLimit returns how many labels the issue list summary shows,
+// falling back to the default when Config.LabelStatsLimit isn't positive
+func (m Model) labelStatsLimit() int {
+	if m.config.LabelStatsLimit <= 0 {
+		return config.DefaultConfig().LabelStatsLimit
+	}
+	return m.config.LabelStatsLimit
+}
+
+// newLabelList creates the list used by the labels screen
+func newLabelList() list.Model {
+	labelList := list.New([]list.Item{}, compactDelegate{}, 0, 0)
+	labelList.Title = "Labels"
+	labelList.SetShowStatusBar(true)
+	labelList.SetFilteringEnabled(true)
+	labelList.SetShowHelp(true)
+	return labelList
+}
+
+// handleLabels opens the browser of every label on the listed issues, most
+// used first, or of every topic on the repo list
+func (m Model) handleLabels() (Model, tea.Cmd) {
+	if m.currentScreen == repoListScreen {
+		return m.handleTopics()
+	}
+	if m.currentScreen != issueListScreen || len(m.labelStats) == 0 {
+		return m, nil
+	}
+
+	labels := sortLabelStats(m.labelStats, m.config.LabelStatsSort)
+	items := make([]list.Item, len(labels))
+	for i, label := range labels {
+		items[i] = label
+	}
+
+	m.labelList.ResetFilter()
+	m.labelList.SetItems(items)
+	m.labelList.Select(0)
+	m.labelList.Title = fmt.Sprintf("Labels on %d issues", len(m.issues))
+	m = m.enterScreen(labelsScreen)
+	return m, nil
+}
+
+// handleLabelSelect filters the issue list to the selected label
+func (m Model) handleLabelSelect() (Model, tea.Cmd) {
+	item, ok := m.labelList.SelectedItem().(labelItem)
+	if !ok {
+		return m, nil
+	}
+	return m.applyLabelFilter(item.name).enterScreen(issueListScreen), nil
+}
+
+// applyLabelFilter narrows the issue list to issues carrying label, replacing
+// any preset narrowing
+func (m Model) applyLabelFilter(label string) Model {
+	m.labelFilter = label
+	m.typeFilter = ""
+	m.preset = nil
+	return m.narrowIssues(func(issue *github.Issue) bool {
+		return hasAnyLabel(issue, []string{label})
+	})
+}
+
+// narrowIssues shows only the issues keep accepts, keeping the full list so
+// clearIssueFilter can bring it back
+func (m Model) narrowIssues(keep func(*github.Issue) bool) Model {
+	if m.unfilteredIssues == nil {
+		m.unfilteredIssues = m.issueList.Items()
+	}
+
+	var items []list.Item
+	for _, item := range m.unfilteredIssues {
+		issue, ok := item.(issueItem)
+		if !ok {
+			continue // section headers would be misleading in a filtered list
+		}
+		if keep(issue.issue) {
+			items = append(items, item)
+		}
+	}
+
+	m.issueList.ResetFilter()
+	m.issueList.SetItems(items)
+	m.issueList.Select(0)
+	return m
+}
+
+// issueFilterActive reports whether a label, contribution type or preset
+// narrows the issue list
+func (m Model) issueFilterActive() bool {
+	return m.labelFilter != "" || m.typeFilter != "" || m.preset != nil
+}
+
+// clearIssueFilter restores the full issue list and drops the label and
+// contribution type filters and the active preset
+func (m Model) clearIssueFilter() Model {
+	if m.unfilteredIssues != nil {
+		m.issueList.SetItems(m.unfilteredIssues)
+		m.issueList.Select(0)
+		skipSectionHeader(&m.issueList, -1)
+	}
+	m.labelFilter = ""
+	m.typeFilter = ""
+	m.preset = nil
+	m.unfilteredIssues = nil
+	return m
+}
+
+func (m Model) labelsScreenView() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.labelList.View(),
+		m.renderFooter(
+			keyHint("Filter", m.labelList.KeyMap.Filter),
+			keyHint("Show issues with this label", m.keys.Enter),
+			keyHint("Back", m.keys.Back),
+		),
+	)
+}
@@ -0,0 +1,90 @@
+package cli
+
+import (
+	"fmt"
+	"math/rand/v2"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// luckyMinWeight keeps issues far from the skill target in the draw, just
+// unlikely
+const luckyMinWeight = 5
+
+// newRandom returns the random source behind the random issue pick, seeded
+// from Config.Seed so a run can be reproduced, or from the clock when unset
+func newRandom(seed int64) *rand.Rand {
+	if seed == 0 {
+		seed = time.Now().UnixNano()
+	}
+	return rand.New(rand.NewPCG(uint64(seed), 0))
+}
+
+// luckyIssueIndex draws the index among items of a random unclaimed, not yet
+// completed issue, weighted toward the difficulty target, or -1 if there is
+// none
+func luckyIssueIndex(items []list.Item, target int, completed func(*github.Issue) bool, rng *rand.Rand) int {
+	var candidates, weights []int
+	total := 0
+	for i, item := range items {
+		issueItem, ok := item.(issueItem)
+		if !ok {
+			continue
+		}
+		issue := issueItem.issue
+		if !claimable(issue, completed) {
+			continue
+		}
+
+		distance := issue.DifficultyScore - target
+		if distance < 0 {
+			distance = -distance
+		}
+		weight := max(luckyMinWeight, 100-distance)
+		if issue.DifficultyScore == github.UnknownDifficulty {
+			weight = luckyMinWeight
+		}
+		candidates = append(candidates, i)
+		weights = append(weights, weight)
+		total += weight
+	}
+	if total == 0 {
+		return -1
+	}
+
+	draw := rng.IntN(total)
+	for i, weight := range weights {
+		if draw < weight {
+			return candidates[i]
+		}
+		draw -= weight
+	}
+	return candidates[len(candidates)-1]
+}
+
+// handleLucky opens a random issue among those shown, favouring ones that
+// suit the configured skill level
+func (m Model) handleLucky() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen {
+		return m, nil
+	}
+
+	target := skillTarget(m.config.SkillLevel)
+
+	idx := luckyIssueIndex(m.issueList.VisibleItems(), target, m.isCompleted, m.rng)
+	if idx < 0 {
+		m.status = "No unassigned issue left to pick from"
+		return m, nil
+	}
+
+	// Select the pick in the list first so going back lands on it
+	issue := m.issueList.VisibleItems()[idx].(issueItem).issue
+	m.issueList.Select(idx)
+	logger.Info(fmt.Sprintf("Picked random issue %s", issueKey(issue)))
+	return m.handleDetails()
+}
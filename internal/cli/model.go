@@ -1,32 +1,69 @@
 package cli
 
 import (
+	"errors"
 	"fmt"
+	"math/rand/v2"
 	"os/exec"
 	"runtime"
+	"sort"
 	"strings"
+	"time"
 
 	"github.com/charmbracelet/bubbles/key"
 	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/textinput"
+	"github.com/charmbracelet/bubbles/viewport"
 	tea "github.com/charmbracelet/bubbletea"
 	"github.com/charmbracelet/lipgloss"
 
+	"hacktober/internal/bookmarks"
 	"hacktober/internal/config"
 	"hacktober/internal/github"
+	"hacktober/internal/history"
 	"hacktober/internal/logger"
+	"hacktober/internal/store"
 )
 
 // keyMap defines keybindings
 type keyMap struct {
-	Up      key.Binding
-	Down    key.Binding
-	Left    key.Binding
-	Right   key.Binding
-	Enter   key.Binding
-	Back    key.Binding
-	Quit    key.Binding
-	Refresh key.Binding
-	Issues  key.Binding
+	Up         key.Binding
+	Down       key.Binding
+	Left       key.Binding
+	Right      key.Binding
+	Enter      key.Binding
+	Back       key.Binding
+	Quit       key.Binding
+	Refresh    key.Binding
+	Issues     key.Binding
+	Readme     key.Binding
+	Details    key.Binding
+	Exclude    key.Binding
+	Relax      key.Binding
+	History    key.Binding
+	Scan       key.Binding
+	Watch      key.Binding
+	Reset      key.Binding
+	Forward    key.Binding
+	Complete   key.Binding
+	Completed  key.Binding
+	Compact    key.Binding
+	Export     key.Binding
+	Pause      key.Binding
+	Tune       key.Binding
+	Best       key.Binding
+	Open       key.Binding
+	Snooze     key.Binding
+	Labels     key.Binding
+	Preset     key.Binding
+	SeenAll    key.Binding
+	Lucky      key.Binding
+	MoreRepos  key.Binding
+	Share      key.Binding
+	Bookmark   key.Binding
+	Kind       key.Binding
+	ShareOther key.Binding
+	FewerRepos key.Binding
 }
 
 func (k keyMap) ShortHelp() []key.Binding {
@@ -36,7 +73,7 @@ func (k keyMap) ShortHelp() []key.Binding {
 func (k keyMap) FullHelp() [][]key.Binding {
 	return [][]key.Binding{
 		{k.Up, k.Down, k.Left, k.Right},
-		{k.Enter, k.Issues, k.Back, k.Refresh, k.Quit},
+		{k.Enter, k.Issues, k.Readme, k.Details, k.Best, k.Lucky, k.Labels, k.Kind, k.Preset, k.Exclude, k.History, k.Scan, k.Pause, k.Tune, k.Watch, k.Open, k.Reset, k.Complete, k.Snooze, k.SeenAll, k.Completed, k.Compact, k.Export, k.Bookmark, k.Share, k.ShareOther, k.MoreRepos, k.FewerRepos, k.Back, k.Forward, k.Refresh, k.Quit},
 	}
 }
 
@@ -62,7 +99,7 @@ var keys = keyMap{
 		key.WithHelp("enter", "open in browser"),
 	),
 	Back: key.NewBinding(
-		key.WithKeys("esc", "q"),
+		key.WithKeys("q", "esc"),
 		key.WithHelp("q/esc", "back"),
 	),
 	Quit: key.NewBinding(
@@ -77,8 +114,123 @@ var keys = keyMap{
 		key.WithKeys("i"),
 		key.WithHelp("i", "view issues"),
 	),
+	Readme: key.NewBinding(
+		key.WithKeys("m"),
+		key.WithHelp("m", "view README"),
+	),
+	Details: key.NewBinding(
+		key.WithKeys("d"),
+		key.WithHelp("d", "issue details"),
+	),
+	Exclude: key.NewBinding(
+		key.WithKeys("x"),
+		key.WithHelp("x", "toggle excluded labels"),
+	),
+	Relax: key.NewBinding(
+		key.WithKeys("y"),
+		key.WithHelp("y", "relax filters and retry"),
+	),
+	History: key.NewBinding(
+		key.WithKeys("H"),
+		key.WithHelp("H", "search history"),
+	),
+	Scan: key.NewBinding(
+		key.WithKeys("g"),
+		key.WithHelp("g", "scan good first issues"),
+	),
+	Watch: key.NewBinding(
+		key.WithKeys("w"),
+		key.WithHelp("w", "watchlist"),
+	),
+	Reset: key.NewBinding(
+		key.WithKeys("R"),
+		key.WithHelp("R", "reset settings to defaults"),
+	),
+	Forward: key.NewBinding(
+		key.WithKeys("f"),
+		key.WithHelp("f", "forward"),
+	),
+	Complete: key.NewBinding(
+		key.WithKeys("c"),
+		key.WithHelp("c", "mark completed"),
+	),
+	Completed: key.NewBinding(
+		key.WithKeys("C"),
+		key.WithHelp("C", "completed issues"),
+	),
+	Compact: key.NewBinding(
+		key.WithKeys("v"),
+		key.WithHelp("v", "toggle compact view"),
+	),
+	Export: key.NewBinding(
+		key.WithKeys("e"),
+		key.WithHelp("e", "export issues or repos"),
+	),
+	Pause: key.NewBinding(
+		key.WithKeys(" "),
+		key.WithHelp("space", "pause/resume scan"),
+	),
+	Tune: key.NewBinding(
+		key.WithKeys("t"),
+		key.WithHelp("t", "tune relevance weights"),
+	),
+	Best: key.NewBinding(
+		key.WithKeys("b"),
+		key.WithHelp("b", "jump to best issue"),
+	),
+	Open: key.NewBinding(
+		key.WithKeys("o"),
+		key.WithHelp("o", "open a repo by name / in browser"),
+	),
+	Snooze: key.NewBinding(
+		key.WithKeys("z"),
+		key.WithHelp("z", "snooze issue"),
+	),
+	Labels: key.NewBinding(
+		key.WithKeys("L"),
+		key.WithHelp("L", "browse labels or topics"),
+	),
+	Preset: key.NewBinding(
+		key.WithKeys("f1", "f2", "f3", "f4"),
+		key.WithHelp("f1-f4", "apply filter preset"),
+	),
+	SeenAll: key.NewBinding(
+		key.WithKeys("a"),
+		key.WithHelp("a", "mark all visible as seen"),
+	),
+	Lucky: key.NewBinding(
+		key.WithKeys("s"),
+		key.WithHelp("s", "surprise me with a random issue"),
+	),
+	Kind: key.NewBinding(
+		key.WithKeys("K"),
+		key.WithHelp("K", "cycle contribution type"),
+	),
+	Bookmark: key.NewBinding(
+		key.WithKeys("B"),
+		key.WithHelp("B", "bookmark issue / bookmarks"),
+	),
+	Share: key.NewBinding(
+		key.WithKeys("p"),
+		key.WithHelp("p", "copy issue card"),
+	),
+	ShareOther: key.NewBinding(
+		key.WithKeys("P"),
+		key.WithHelp("P", "copy issue card in the other format"),
+	),
+	MoreRepos: key.NewBinding(
+		key.WithKeys("+", "="),
+		key.WithHelp("+", "more repos per page"),
+	),
+	FewerRepos: key.NewBinding(
+		key.WithKeys("-"),
+		key.WithHelp("-", "fewer repos per page"),
+	),
 }
 
+// readmeExcerptLines limits how much of a README is shown in the viewer
+const readmeExcerptLines = 30
+
 // Screen states
 type screen int
 
@@ -87,20 +239,66 @@ const (
 	repoListScreen
 	issueListScreen
 	issueDetailScreen
+	readmeScreen
+	historyScreen
+	scanScreen
+	completedScreen
+	tunerScreen
+	labelsScreen
+	bookmarksScreen
+	topicsScreen
 )
 
 // Messages for communication between components
 type reposLoadedMsg struct {
-	repos        []*github.Repository
-	totalRepoCnt int
-	currentPage  int
-	hasMore      bool
-	resetToFirst bool // true for right/next page, false for left/prev page
+	repos         []*github.Repository
+	totalRepoCnt  int
+	candidateCnt  int
+	currentPage   int
+	hasMore       bool
+	resetToFirst  bool      // true for right/next page, false for left/prev page
+	keepSelection bool      // re-select the previously selected repo (refresh)
+	broadened     bool      // the search was widened beyond the preferred languages
+	incomplete    bool      // GitHub timed out on a search and returned partial results
+	cachedAt      time.Time // set for results saved by a previous session
+	background    bool      // refresh of cached results, don't switch screens
+	apiCalls      int       // requests the search and readiness checks cost
+	status        string    // replaces the API call count in the status line
+	autoRefresh   bool      // idle auto-refresh, badge repos the session hasn't listed before
+
+	// readiness is set on the repos in Update with CheckReadiness, since
+	// cached searches hand back the repos already on screen
+	readiness map[*github.Repository]*github.Readiness
 }
 
 type issuesLoadedMsg struct {
-	issues     []*github.Issue
-	labelStats map[string]int
+	source         string // repo identity, or a pseudo-source such as the watchlist
+	title          string // header override for lists not tied to one repo
+	note           string // extra context shown above the list
+	issues         []*github.Issue
+	labelStats     map[string]int
+	excludedCount  int
+	takenCount     int
+	discussedCount int
+	commentedCount int
+	assignedCount  int
+	background     bool // refresh the list without switching to it
+	apiCalls       int  // requests the load cost, 0 for lists not fetched from GitHub
+}
+
+// openRepoMsg opens a repository's issues directly, e.g. one given at startup
+type openRepoMsg struct {
+	repo *github.Repository
+}
+
+type readmeLoadedMsg struct {
+	repo           *github.Repository
+	content        string
+	commitActivity []int
+}
+
+type historyLoadedMsg struct {
+	summaries []history.SearchSummary
 }
 
 type repoSelectedMsg struct {
@@ -108,13 +306,41 @@ type repoSelectedMsg struct {
 }
 
 type issueSelectedMsg struct {
-	issue *github.Issue
+	issue     *github.Issue
+	linkedPRs []github.LinkedPR
+	projects  []github.ProjectCard
+	activity  []github.TimelineEvent
 }
 
 type errorMsg struct {
 	err error
 }
 
+// browserFailedMsg reports a URL that couldn't be opened. It only sets the
+// status, since an errorMsg would replace the screen with a load error.
+type browserFailedMsg struct {
+	err error
+}
+
+// readmeFailedMsg reports a README that couldn't be loaded. Like
+// browserFailedMsg it only sets the status, so the list stays usable.
+type readmeFailedMsg struct {
+	repo *github.Repository
+	err  error
+}
+
+// windowSizeTimeoutMsg fires once windowSizeTimeout has passed after start,
+// see Init
+type windowSizeTimeoutMsg struct{}
+
+// Terminals and pipes that never report a size get fallbackWidth by
+// fallbackHeight after windowSizeTimeout instead of loading forever
+const (
+	windowSizeTimeout = 500 * time.Millisecond
+	fallbackWidth     = 80
+	fallbackHeight    = 24
+)
+
 // Main model
 type Model struct {
 	config        *config.Config
@@ -122,59 +348,159 @@ type Model struct {
 	currentScreen screen
 
 	// Data
-	repos         []*github.Repository
-	issues        []*github.Issue
-	labelStats    map[string]int
-	selectedRepo  *github.Repository
-	selectedIssue *github.Issue
+	repos            []*github.Repository
+	topicStats       map[string]int // repositories carrying each topic on the repo list
+	topicFilter      string         // topic the repo list is narrowed to, if any
+	unfilteredRepos  []list.Item    // repo list items before the topic filter
+	issues           []*github.Issue
+	labelStats       map[string]int
+	excludedCount    int
+	takenCount       int
+	discussedCount   int
+	commentedCount   int
+	assignedCount    int
+	snoozedCount     int
+	seenCount        int
+	unknownCount     int                  // hidden for having unknown difficulty
+	issuesSource     string               // repo identity, or a pseudo-source such as the watchlist
+	issuesTitle      string               // header override for lists not tied to one repo
+	issuesNote       string               // extra context shown above the issue list
+	labelFilter      string               // label the issue list is narrowed to, if any
+	typeFilter       string               // contribution type the issue list is narrowed to, if any
+	preset           *config.FilterPreset // preset narrowing every issue list, if any
+	unfilteredIssues []list.Item          // issue list items before the label or preset filter
+	selectedRepo     *github.Repository
+	selectedIssue    *github.Issue
+	linkedPRs        []github.LinkedPR      // linked PRs of selectedIssue
+	projects         []github.ProjectCard   // project boards selectedIssue is on
+	activity         []github.TimelineEvent // condensed timeline of selectedIssue
+	commitActivity   []int                  // weekly commits of selectedRepo, with Config.ShowActivity
 
 	// Pagination state
 	currentPage  int
 	totalRepos   int
+	candidateCnt int
 	hasMorePages bool
+	perPage      int       // page size picked with the page size keys, zero for Config.MaxRepos
+	broadened    bool      // results include a broadened search
+	incomplete   bool      // results are partial because a GitHub search timed out
+	cachedAt     time.Time // when the shown results were saved, zero once refreshed
+
+	// Filter state
+	excludeLabels  bool            // whether Config.ExcludeIssueLabels is applied
+	relaxSteps     []string        // filters loosened by the last relax-and-retry
+	relaxExhausted bool            // relax-and-retry ran out of filters to loosen
+	relaxed        *relaxedFilters // filters the repo list was searched with after relaxing, if any
+	criteria       *searchCriteria // search filters of a re-run search or preset, replacing the config's
+	pendingSearch  bool            // next loaded repo page is a new search to record in history
 
 	// UI state
-	loading bool
-	error   error
-	width   int
-	height  int
+	status        string        // one-off feedback about the last action
+	confirmReset  bool          // waiting for the user to confirm resetting settings
+	confirmExport bool          // waiting for the user to pick an issue export format
+	tuner         tunerState    // relevance weights being tuned on the tuner screen
+	forward       []navSnapshot // screens left with back, most recent last
+	prInput       textinput.Model
+	prTarget      *github.Issue // issue being marked as completed, while prompting for its PR
+	repoInput     textinput.Model
+	promptRepo    bool               // prompting for a repository to open on the welcome screen
+	startRepo     *github.Repository // repository opened at startup instead of the welcome screen
+	detailFields  []string           // issue detail fields to show, in order
+	loading       bool
+	error         error
+	width         int
+	height        int
+
+	// Cross-repo scan state
+	scan *scanState // non-nil while a scan is running
+
+	// Persistent local state
+	store          *store.Store
+	visitBaselines map[string]time.Time // last visit per repo as of the start of this session
+	knownRepos     map[string]bool      // every repo listed this session, to badge new ones on auto-refresh
+	lastKeyAt      time.Time            // when the last key was pressed, auto-refresh waits for idle
 
 	// Components
-	repoList  list.Model
-	issueList list.Model
-
+	repoList      list.Model
+	issueList     list.Model
+	labelList     list.Model
+	topicList     list.Model
+	readmeView    viewport.Model
+	historyList   list.Model
+	bookmarksList list.Model
+	completedView viewport.Model
+
+	rng  *rand.Rand // draws the random issue pick, see newRandom
 	keys keyMap
 }
 
 // Repository list item for bubbles list
 type repoItem struct {
-	repo *github.Repository
+	repo       *github.Repository
+	showScores bool
+	isNew      bool // first listed by an auto-refresh
 }
 
+// FilterValue matches on owner/name and the description, which many
+// repositories don't have
 func (i repoItem) FilterValue() string {
-	return fmt.Sprintf("%s/%s %s",
-		*i.repo.Repository.Owner.Login,
-		*i.repo.Repository.Name,
-		*i.repo.Repository.Description)
+	description := i.repo.Repository.GetDescription()
+	if description == "" {
+		return repoKey(i.repo)
+	}
+	return repoKey(i.repo) + " " + description
 }
 
 func (i repoItem) Title() string {
-	return fmt.Sprintf("%s/%s", *i.repo.Repository.Owner.Login, *i.repo.Repository.Name)
+	title := repoKey(i.repo)
+	switch i.repo.Repository.GetOwner().GetType() {
+	case "Organization":
+		title = icons.Org + " " + title
+	case "User":
+		title = icons.User + " " + title
+	}
+	if i.repo.Pinned {
+		title = icons.Pinned + " " + title
+	}
+	if i.isNew {
+		title += " [NEW]"
+	}
+	return title
 }
 
 func (i repoItem) Description() string {
 	// First line: stars, language, and score
-	stars := ""
-	if i.repo.Repository.StargazersCount != nil {
-		stars = fmt.Sprintf("⭐ %d", *i.repo.Repository.StargazersCount)
-	}
+	stars := icons.Star + " " + formatStars(i.repo)
 
 	lang := ""
 	if i.repo.Repository.Language != nil {
 		lang = fmt.Sprintf("• %s", *i.repo.Repository.Language)
 	}
 
-	score := fmt.Sprintf("[Score: %d]", i.repo.RelevanceScore)
+	// Leave out empty parts so the line reflows when something is hidden
+	var summary []string
+	for _, part := range []string{stars, lang} {
+		if part != "" {
+			summary = append(summary, part)
+		}
+	}
+	if i.showScores {
+		summary = append(summary, FormatRelevanceScore(i.repo.RelevanceScore))
+	}
+	if i.repo.Readiness != nil {
+		summary = append(summary, "Readiness "+FormatReadiness(i.repo.Readiness.Score(), github.MaxReadinessScore))
+	}
+	if i.repo.Broadened {
+		summary = append(summary, icons.Broadened+" broadened search")
+	}
+	if i.repo.Dependency {
+		summary = append(summary, icons.Dependency+" you depend on this")
+	}
+	if i.repo.Contributed {
+		summary = append(summary, icons.Contributed+" you already contribute here")
+	} else if i.repo.Starred {
+		summary = append(summary, icons.Starred+" you starred this")
+	}
 
 	// Second line: repository description
 	desc := ""
@@ -184,17 +510,24 @@ func (i repoItem) Description() string {
 		if len(desc) > 100 {
 			desc = desc[:97] + "..."
 		}
-		desc = "📝 " + desc
+		desc = icons.Description + " " + desc
 	} else {
-		desc = "📝 No description available"
+		desc = icons.Description + " No description available"
 	}
 
-	return fmt.Sprintf("%s %s %s\n%s", stars, lang, score, desc)
+	return fmt.Sprintf("%s\n%s", strings.Join(summary, " "), desc)
 }
 
 // Issue list item for bubbles list
 type issueItem struct {
-	issue *github.Issue
+	issue      *github.Issue
+	badge      string   // "NEW" or "UPDATED" since the last visit, if any
+	similarTo  int      // number of an issue with a near-identical title, if any
+	completed  bool     // marked as completed with a submitted PR
+	snoozeBack bool     // snoozed earlier and resurfaced since
+	highlight  []string // Config.HighlightLabels
+	showAuthor bool     // Config.ShowAuthorAssociation
+	kind       string   // contribution type from Config.ContributionTypeLabels, if any
 }
 
 func (i issueItem) FilterValue() string {
@@ -202,16 +535,38 @@ func (i issueItem) FilterValue() string {
 }
 
 func (i issueItem) Title() string {
-	difficulty := ""
-	switch {
-	case i.issue.DifficultyScore <= 30:
-		difficulty = "[Easy]"
-	case i.issue.DifficultyScore <= 60:
-		difficulty = "[Medium]"
-	case i.issue.DifficultyScore <= 80:
-		difficulty = "[Hard]"
-	default:
-		difficulty = "[Expert]"
+	difficulty := "[" + difficultyName(i.issue.DifficultyScore) + "]"
+
+	if i.kind != "" {
+		difficulty += " [" + i.kind + "]"
+	}
+
+	if i.badge != "" {
+		difficulty += " [" + i.badge + "]"
+	}
+
+	if i.issue.Issue.GetState() == "closed" {
+		difficulty += " [closed]"
+	}
+
+	if i.completed {
+		difficulty += " " + icons.Completed + " PR submitted"
+	}
+
+	if i.snoozeBack {
+		difficulty += " " + icons.Snoozed + " snoozed issue is back"
+	}
+
+	if i.issue.Commented {
+		difficulty += " " + icons.Comment + " you commented"
+	}
+
+	if i.issue.Assigned {
+		difficulty += " " + icons.Assigned + " assigned"
+	}
+
+	if i.issue.Repository != nil {
+		return fmt.Sprintf("%s#%d: %s %s", repoKey(i.issue.Repository), *i.issue.Issue.Number, *i.issue.Issue.Title, difficulty)
 	}
 
 	return fmt.Sprintf("#%d: %s %s", *i.issue.Issue.Number, *i.issue.Issue.Title, difficulty)
@@ -220,7 +575,7 @@ func (i issueItem) Title() string {
 func (i issueItem) Description() string {
 	comments := ""
 	if i.issue.Issue.Comments != nil && *i.issue.Issue.Comments > 0 {
-		comments = fmt.Sprintf("💬 %d", *i.issue.Issue.Comments)
+		comments = fmt.Sprintf("%s %d", icons.Comment, *i.issue.Issue.Comments)
 	}
 
 	created := i.issue.Issue.CreatedAt.Format("Jan 2, 2006")
@@ -232,48 +587,95 @@ func (i issueItem) Description() string {
 			break
 		}
 	}
-	labelStr := strings.Join(labels, ", ")
+	labelStr := RenderLabels(labels, i.highlight)
 
-	return fmt.Sprintf("%s • Created: %s\nLabels: %s", comments, created, labelStr)
+	similar := ""
+	if i.similarTo != 0 {
+		similar = fmt.Sprintf(" • %s similar to #%d", icons.Warning, i.similarTo)
+	}
+
+	author := ""
+	if role := authorRole(i.issue); i.showAuthor && role != "" {
+		author = " • by " + role
+	}
+
+	return fmt.Sprintf("%s • Created: %s%s%s\nLabels: %s", comments, created, author, similar, labelStr)
 }
 
 // Initialize the model
 func NewModel(cfg *config.Config) Model {
 	// Create repository list with custom delegate for better description display
-	delegate := list.NewDefaultDelegate()
-	delegate.SetHeight(4)  // Allow more space for descriptions (title + description lines)
-	delegate.SetSpacing(0) // No extra spacing, let content determine spacing
-
-	repoList := list.New([]list.Item{}, delegate, 0, 0)
+	repoList := list.New([]list.Item{}, repoDelegate(cfg.CompactList), 0, 0)
 	repoList.Title = "Hacktoberfest Repositories"
 	repoList.SetShowStatusBar(false) // Hide the built-in item count
 	repoList.SetFilteringEnabled(true)
 	repoList.SetShowHelp(true)
 
 	// Create issue list
-	issueDelegate := list.NewDefaultDelegate()
-	issueDelegate.SetHeight(3) // Allow for issue title + details
-	issueDelegate.SetSpacing(0)
-
-	issueList := list.New([]list.Item{}, issueDelegate, 0, 0)
+	issueList := list.New([]list.Item{}, issueDelegate(cfg.CompactList), 0, 0)
 	issueList.Title = "Repository Issues"
 	issueList.SetShowStatusBar(true)
 	issueList.SetFilteringEnabled(true)
 	issueList.SetShowHelp(true)
 
+	localStore, err := store.Load()
+	if err != nil {
+		logger.ErrorWithErr("Failed to load local store, starting fresh", err)
+	}
+
+	client := github.NewClientWithCache(cfg.GitHubToken, time.Duration(cfg.ResultCacheTTL)*time.Second)
+	applyClientSettings(client, cfg)
+	setIcons(cfg.UseEmoji)
+	setDifficultyColors(cfg.DifficultyColors)
+
 	return Model{
-		config:        cfg,
-		github:        github.NewClient(cfg.GitHubToken),
-		currentScreen: welcomeScreen,
-		currentPage:   1,
-		repoList:      repoList,
-		issueList:     issueList,
-		keys:          keys,
+		config:         cfg,
+		store:          localStore,
+		visitBaselines: make(map[string]time.Time),
+		knownRepos:     make(map[string]bool),
+		github:         client,
+		currentScreen:  welcomeScreen,
+		currentPage:    1,
+		excludeLabels:  true,
+		repoList:       repoList,
+		issueList:      issueList,
+		labelList:      newLabelList(),
+		topicList:      newTopicList(),
+		readmeView:     viewport.New(0, 0),
+		historyList:    newHistoryList(),
+		bookmarksList:  newBookmarksList(),
+		completedView:  viewport.New(0, 0),
+		detailFields:   validDetailFields(cfg.IssueDetailFields),
+		rng:            newRandom(cfg.Seed),
+		keys:           keys,
+	}
+}
+
+// typingFilter reports whether the list on screen is taking filter input
+func (m Model) typingFilter() bool {
+	switch m.currentScreen {
+	case repoListScreen:
+		return m.repoList.FilterState() == list.Filtering
+	case issueListScreen:
+		return m.issueList.FilterState() == list.Filtering
+	case labelsScreen:
+		return m.labelList.FilterState() == list.Filtering
+	case topicsScreen:
+		return m.topicList.FilterState() == list.Filtering
+	case historyScreen:
+		return m.historyList.FilterState() == list.Filtering
+	case bookmarksScreen:
+		return m.bookmarksList.FilterState() == list.Filtering
 	}
+	return false
 }
 
 func (m Model) Init() tea.Cmd {
-	return nil
+	sizeTimeout := tea.Tick(windowSizeTimeout, func(time.Time) tea.Msg { return windowSizeTimeoutMsg{} })
+	if m.startRepo != nil {
+		return tea.Batch(sizeTimeout, func() tea.Msg { return openRepoMsg{repo: m.startRepo} }, m.scheduleAutoRefresh())
+	}
+	return tea.Batch(sizeTimeout, m.loadLastResults(), m.scheduleAutoRefresh())
 }
 
 func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
@@ -282,26 +684,89 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 
 	switch msg := msg.(type) {
 	case tea.WindowSizeMsg:
-		m.width = msg.Width
-		m.height = msg.Height
-		m.repoList.SetWidth(msg.Width)
-		m.repoList.SetHeight(msg.Height - 10) // Leave space for header/footer
-		m.issueList.SetWidth(msg.Width)
-		m.issueList.SetHeight(msg.Height - 10)
+		m = m.resize(msg.Width, msg.Height)
+
+	case windowSizeTimeoutMsg:
+		if m.width == 0 {
+			logger.Warn(fmt.Sprintf("No terminal size reported after %v, assuming %dx%d", windowSizeTimeout, fallbackWidth, fallbackHeight))
+			m = m.resize(fallbackWidth, fallbackHeight)
+		}
 
 	case tea.KeyMsg:
-		if m.loading {
-			// Don't process keys while loading
+		m.lastKeyAt = time.Now()
+		if m.loading && !key.Matches(msg, m.keys.Quit) {
+			// Don't process keys while loading, except quitting, which also
+			// ends a search waiting for a rate limit to reset
 			return m, nil
 		}
+		m.status = ""
+
+		if m.prTarget != nil {
+			return m.handlePRInput(msg)
+		}
+
+		if m.promptRepo {
+			return m.handleRepoInput(msg)
+		}
+
+		// While a list filter is being typed, every key belongs to the filter
+		if m.typingFilter() {
+			break
+		}
+
+		if m.confirmReset {
+			return m.handleResetConfirm(msg)
+		}
+
+		if m.confirmExport {
+			return m.handleExportChoice(msg)
+		}
+
+		if m.currentScreen == tunerScreen && !key.Matches(msg, m.keys.Quit) {
+			return m.handleTunerKey(msg)
+		}
 
 		switch {
 		case key.Matches(msg, m.keys.Quit):
-			return m, tea.Quit
+			return m.quit()
 
 		case key.Matches(msg, m.keys.Back):
 			return m.handleBack()
 
+		case key.Matches(msg, m.keys.Forward):
+			return m.handleForward()
+
+		case key.Matches(msg, m.keys.Complete):
+			return m.handleComplete()
+
+		case key.Matches(msg, m.keys.Snooze):
+			return m.handleSnooze()
+
+		case key.Matches(msg, m.keys.SeenAll):
+			return m.handleMarkAllSeen()
+
+		case key.Matches(msg, m.keys.Labels):
+			return m.handleLabels()
+
+		case key.Matches(msg, m.keys.Preset):
+			return m.handlePreset(msg)
+
+		case key.Matches(msg, m.keys.Export):
+			return m.handleExport()
+
+		case key.Matches(msg, m.keys.Kind):
+			return m.handleContributionType()
+
+		case key.Matches(msg, m.keys.Compact):
+			if m.currentScreen == repoListScreen || m.currentScreen == issueListScreen {
+				return m.toggleCompact(), nil
+			}
+
+		case key.Matches(msg, m.keys.Completed):
+			if m.currentScreen == welcomeScreen {
+				return m.showCompleted(), nil
+			}
+
 		case key.Matches(msg, m.keys.Left):
 			if m.currentScreen == repoListScreen && m.currentPage > 1 {
 				m.loading = true
@@ -322,30 +787,135 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		case key.Matches(msg, m.keys.Issues):
 			return m.handleIssues()
 
+		case key.Matches(msg, m.keys.Readme):
+			return m.handleReadme()
+
+		case key.Matches(msg, m.keys.Details):
+			return m.handleDetails()
+
+		case key.Matches(msg, m.keys.Exclude):
+			return m.handleExcludeToggle()
+
+		case key.Matches(msg, m.keys.Relax):
+			if m.currentScreen == repoListScreen && len(m.repos) == 0 && !m.relaxExhausted {
+				m.loading = true
+				m.pendingSearch = true
+				return m, m.relaxAndSearch()
+			}
+
+		case key.Matches(msg, m.keys.Scan):
+			return m.handleScan()
+
+		case key.Matches(msg, m.keys.Pause):
+			return m.handleScanPause()
+
+		case key.Matches(msg, m.keys.Tune):
+			return m.handleTuner()
+
+		case key.Matches(msg, m.keys.Best):
+			return m.handleJumpToBest()
+
+		case key.Matches(msg, m.keys.Lucky):
+			return m.handleLucky()
+
+		case key.Matches(msg, m.keys.Bookmark):
+			return m.handleBookmark()
+
+		case key.Matches(msg, m.keys.Share):
+			return m.handleShare(false)
+
+		case key.Matches(msg, m.keys.ShareOther):
+			return m.handleShare(true)
+
+		case key.Matches(msg, m.keys.MoreRepos):
+			return m.handlePageSize(pageSizeStep)
+
+		case key.Matches(msg, m.keys.FewerRepos):
+			return m.handlePageSize(-pageSizeStep)
+
+		case key.Matches(msg, m.keys.Open):
+			if m.currentScreen == welcomeScreen {
+				return m.handleOpenRepo()
+			}
+			return m.handleOpenInBrowser()
+
+		case key.Matches(msg, m.keys.Watch):
+			return m.handleWatchlist()
+
+		case key.Matches(msg, m.keys.Reset):
+			if m.currentScreen == welcomeScreen {
+				m.confirmReset = true
+			}
+
+		case key.Matches(msg, m.keys.History):
+			if m.currentScreen == welcomeScreen {
+				return m, m.loadHistory()
+			}
+
 		case key.Matches(msg, m.keys.Refresh):
 			return m.handleRefresh()
 		}
 
 	case reposLoadedMsg:
-		m.loading = false
+		if !msg.background {
+			m.loading = false // a background refresh may finish during another load
+			m.error = nil
+		}
+		for repo, readiness := range msg.readiness {
+			repo.Readiness = readiness
+		}
+		if m.pendingSearch {
+			m.pendingSearch = false
+			m.recordHistory(m.searchEntry(msg.candidateCnt, msg.currentPage))
+		}
+		prevSelected := ""
+		if item, ok := m.repoList.SelectedItem().(repoItem); ok {
+			prevSelected = repoKey(item.repo)
+		}
+
 		m.repos = msg.repos
+		m.topicStats = countTopics(msg.repos)
+		m.topicFilter = ""
+		m.unfilteredRepos = nil
 		m.currentPage = msg.currentPage
 		m.totalRepos = msg.totalRepoCnt
+		m.candidateCnt = msg.candidateCnt
+		m.broadened = msg.broadened
+		m.incomplete = msg.incomplete
 		m.hasMorePages = msg.hasMore
+		m.cachedAt = msg.cachedAt
 
 		// Update title with just total count, no page details
-		m.repoList.Title = fmt.Sprintf("Hacktoberfest Repositories (~%d total found)", msg.totalRepoCnt)
+		m.repoList.Title = "Hacktoberfest Repositories (total unknown)"
+		if msg.totalRepoCnt != github.UnknownTotal {
+			m.repoList.Title = fmt.Sprintf("Hacktoberfest Repositories (~%d total found)", msg.totalRepoCnt)
+		}
+		if msg.incomplete {
+			m.repoList.Title += " " + icons.Warning + " partial results (GitHub search timed out)"
+		}
 
 		// Convert to list items
 		items := make([]list.Item, len(msg.repos))
 		for i, repo := range msg.repos {
-			items[i] = repoItem{repo: repo}
+			isNew := msg.autoRefresh && !m.knownRepos[repoKey(repo)]
+			m.knownRepos[repoKey(repo)] = true
+			items[i] = repoItem{repo: repo, showScores: m.config.ShowScores, isNew: isNew}
 		}
 
 		m.repoList.SetItems(items)
 
-		// Set cursor position based on navigation direction
-		if len(items) > 0 {
+		// Keep the selected repo pointing at fresh data after a reload
+		if m.selectedRepo != nil {
+			if idx := indexOfRepo(msg.repos, repoKey(m.selectedRepo)); idx >= 0 {
+				m.selectedRepo = msg.repos[idx]
+			}
+		}
+
+		// Set cursor position based on navigation direction, or on the same
+		// repo as before when refreshing so the selection never silently moves
+		if idx := indexOfRepo(msg.repos, prevSelected); msg.keepSelection && idx >= 0 {
+			m.repoList.Select(idx)
+		} else if len(items) > 0 {
 			if msg.resetToFirst {
 				m.repoList.Select(0) // Go to first item
 			} else {
@@ -353,25 +923,207 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 			}
 		}
 
-		m.currentScreen = repoListScreen
+		if !msg.background {
+			m = m.enterScreen(repoListScreen)
+			if msg.status != "" {
+				m.status = msg.status
+			} else if msg.cachedAt.IsZero() {
+				m.status = apiCallsStatus(msg.apiCalls)
+			}
+		}
+
+	case autoRefreshMsg:
+		return m.handleAutoRefresh()
+
+	case cacheRefreshFailedMsg:
+		logger.ErrorWithErr("Refreshing cached results failed", msg.err)
+		m.status = fmt.Sprintf("Showing cached results, refresh failed: %v", msg.err)
 
 	case issuesLoadedMsg:
-		m.loading = false
-		m.issues = msg.issues
+		if !msg.background {
+			m.loading = false
+			m.error = nil
+		}
+		m.issues, m.snoozedCount = m.hideSnoozed(mergeIssues(nil, msg.issues))
+		m.issues, m.seenCount = m.hideSeen(m.issues)
+		m.issues, m.unknownCount = m.hideUnknownDifficulty(m.issues)
 		m.labelStats = msg.labelStats
+		if m.labelStats == nil || len(m.issues) != len(msg.issues) {
+			m.labelStats = countLabels(m.issues)
+		}
+		m.excludedCount = msg.excludedCount
+		m.takenCount = msg.takenCount
+		m.labelFilter = ""
+		m.typeFilter = ""
+		m.unfilteredIssues = nil
+		m.discussedCount = msg.discussedCount
+		m.commentedCount = msg.commentedCount
+		m.assignedCount = msg.assignedCount
+
+		// Mark issues that changed since the last visit to this repository
+		lastVisit := m.recordVisit()
+		badges := make(map[*github.Issue]string, len(m.issues))
+		for _, issue := range m.issues {
+			badges[issue] = visitBadge(issue, lastVisit)
+		}
+		if m.config.SortBySkillLevel {
+			m.issues = FilterIssuesBySkill(m.issues, m.config.SkillLevel)
+		}
+		if m.config.SortByFirstResponse {
+			sortByFirstResponse(m.issues)
+		}
+		if m.config.PreferMaintainerIssues {
+			sort.SliceStable(m.issues, func(i, j int) bool {
+				return byMaintainer(m.issues[i]) && !byMaintainer(m.issues[j])
+			})
+		}
+		if m.config.NewIssuesFirst {
+			sort.SliceStable(m.issues, func(i, j int) bool {
+				return badges[m.issues[i]] != "" && badges[m.issues[j]] == ""
+			})
+		}
 
-		// Convert to list items
-		items := make([]list.Item, len(msg.issues))
-		for i, issue := range msg.issues {
-			items[i] = issueItem{issue: issue}
+		similar := similarIssues(m.issues)
+		items := issueListItems(m.issues, func(issue *github.Issue) issueItem {
+			return issueItem{
+				issue:      issue,
+				badge:      badges[issue],
+				similarTo:  similar[issue],
+				completed:  m.isCompleted(issue),
+				snoozeBack: m.isBackFromSnooze(issue),
+				highlight:  m.config.HighlightLabels,
+				showAuthor: m.config.ShowAuthorAssociation,
+				kind:       m.contributionType(issue),
+			}
+		}, m.config.GroupIssuesByDifficulty)
+
+		// Only carry the selection over when reloading the same repo
+		prevIndex, prevNumber := 0, 0
+		if msg.source == m.issuesSource {
+			prevIndex = m.issueList.Index()
+			if item, ok := m.issueList.SelectedItem().(issueItem); ok {
+				prevNumber = item.issue.Issue.GetNumber()
+			}
 		}
+		m.issuesSource = msg.source
+		m.issuesTitle = msg.title
+		m.issuesNote = msg.note
 
 		m.issueList.SetItems(items)
-		m.currentScreen = issueListScreen
+		if m.preset != nil {
+			m = m.applyPreset()
+			items = m.issueList.Items()
+		}
+
+		// Re-select the same issue by number after a reload, otherwise clamp
+		// the cursor to the new list length
+		if idx := indexOfIssueItem(items, prevNumber); idx >= 0 {
+			m.issueList.Select(idx)
+		} else if len(items) > 0 {
+			m.issueList.Select(min(prevIndex, len(items)-1))
+			skipSectionHeader(&m.issueList, -1)
+		}
+		if m.selectedIssue != nil && indexOfIssue(m.issues, m.selectedIssue.Issue.GetNumber()) < 0 {
+			m.selectedIssue = nil
+		}
+		if !msg.background {
+			m = m.enterScreen(issueListScreen)
+			if msg.apiCalls > 0 {
+				m.status = apiCallsStatus(msg.apiCalls)
+			}
+		}
+
+	case scanProgressMsg:
+		if m.scan != nil {
+			m.scan.progress = msg.progress
+			m.scan.found = append(m.scan.found, msg.progress.Issues...)
+
+			// Stream new finds into the list while it is being browsed
+			if m.issuesSource == scanSource && len(msg.progress.Issues) > 0 {
+				updated, _ := m.Update(scanResultsMsg(m.scan.found, m.scanStatus(), true))
+				m = updated.(Model)
+			}
+			return m, waitForScan(m.scan)
+		}
+
+	case scanDoneMsg:
+		onScanScreen := m.currentScreen == scanScreen
+		m.scan = nil
+		note := ""
+		if msg.result.Partial {
+			note = fmt.Sprintf("Partial results: scanned %d/%d repos, %s", msg.result.Scanned, msg.result.Total, msg.result.Note)
+		}
+
+		// Finishing in the background only refreshes a list of scan results
+		// that is already open, and never switches screens
+		if !onScanScreen {
+			if m.issuesSource == scanSource {
+				return m.Update(scanResultsMsg(msg.result.Issues, note, true))
+			}
+			m.status = fmt.Sprintf("Good first issue scan finished with %d issues", len(msg.result.Issues))
+			return m, nil
+		}
+
+		// Scan results span repositories, so there is no single selected repo
+		m.selectedRepo = nil
+		m.selectedIssue = nil
+		m.excludedCount = 0
+		m.takenCount = 0
+		m.discussedCount = 0
+		m.commentedCount = 0
+		m.assignedCount = 0
+		return m.Update(scanResultsMsg(msg.result.Issues, note, false))
+
+	case filtersRelaxedMsg:
+		// Page through the results with the loosened filters, leaving the
+		// config as it was
+		m.relaxed = &msg.filters
+		m.relaxSteps = msg.steps
+		m.relaxExhausted = msg.exhausted
+		return m.Update(msg.loaded)
+
+	case bookmarksLoadedMsg:
+		m = m.showBookmarks(msg.bookmarks)
+
+	case historyLoadedMsg:
+		m.historyList.SetItems(historyItems(msg.summaries))
+		m.historyList.Select(len(msg.summaries) - 1) // the latest search
+		m = m.enterScreen(historyScreen)
+
+	case issueSelectedMsg:
+		m.loading = false
+		m.selectedIssue = msg.issue
+		m.linkedPRs = msg.linkedPRs
+		m.projects = msg.projects
+		m.activity = msg.activity
+		m = m.acknowledgeSnooze(msg.issue)
+		m = m.enterScreen(issueDetailScreen)
+
+	case readmeLoadedMsg:
+		m.loading = false
+		m.selectedRepo = msg.repo
+		m.commitActivity = msg.commitActivity
+		m.readmeView.SetContent(msg.content)
+		m.readmeView.GotoTop()
+		m = m.enterScreen(readmeScreen)
 
 	case errorMsg:
 		m.loading = false
 		m.error = msg.err
+
+	case browserFailedMsg:
+		m.status = fmt.Sprintf("Couldn't open the browser: %v", msg.err)
+
+	case readmeFailedMsg:
+		m.loading = false
+		if errors.Is(msg.err, github.ErrNoReadme) {
+			m.status = fmt.Sprintf("%s/%s has no README", *msg.repo.Repository.Owner.Login, *msg.repo.Repository.Name)
+		} else {
+			m.status = fmt.Sprintf("Couldn't load the README: %v", msg.err)
+		}
+
+	case openRepoMsg:
+		return m.openRepo(msg.repo)
 	}
 
 	// Update the current list component
@@ -380,35 +1132,157 @@ func (m Model) Update(msg tea.Msg) (tea.Model, tea.Cmd) {
 		m.repoList, cmd = m.repoList.Update(msg)
 		cmds = append(cmds, cmd)
 	case issueListScreen:
+		prevIndex := m.issueList.Index()
 		m.issueList, cmd = m.issueList.Update(msg)
+		skipSectionHeader(&m.issueList, prevIndex)
+		cmds = append(cmds, cmd)
+	case readmeScreen:
+		m.readmeView, cmd = m.readmeView.Update(msg)
+		cmds = append(cmds, cmd)
+	case historyScreen:
+		m.historyList, cmd = m.historyList.Update(msg)
+		cmds = append(cmds, cmd)
+	case bookmarksScreen:
+		m.bookmarksList, cmd = m.bookmarksList.Update(msg)
+		cmds = append(cmds, cmd)
+	case completedScreen:
+		m.completedView, cmd = m.completedView.Update(msg)
+		cmds = append(cmds, cmd)
+	case labelsScreen:
+		m.labelList, cmd = m.labelList.Update(msg)
+		cmds = append(cmds, cmd)
+	case topicsScreen:
+		m.topicList, cmd = m.topicList.Update(msg)
 		cmds = append(cmds, cmd)
 	}
 
 	return m, tea.Batch(cmds...)
 }
 
+// resize lays the lists and viewports out for a terminal of the given size
+func (m Model) resize(width, height int) Model {
+	m.width = width
+	m.height = height
+	content := m.contentHeight() // Leave space for header/footer
+	m.repoList.SetSize(width, content)
+	m.issueList.SetSize(width, content)
+	m.readmeView.Width = width
+	m.readmeView.Height = content
+	m.historyList.SetSize(width, content)
+	m.bookmarksList.SetSize(width, content)
+	m.completedView.Width = width
+	m.completedView.Height = content
+	m.labelList.SetSize(width, content)
+	m.topicList.SetSize(width, content)
+	return m
+}
+
+// contentHeight returns the height available to lists and viewports after
+// reserving Config.HeaderFooterReserve lines, kept within the terminal height
+func (m Model) contentHeight() int {
+	reserve := max(0, min(m.config.HeaderFooterReserve, m.height-1))
+	return max(1, m.height-reserve)
+}
+
+// quit saves the results on screen, stops any scan or rate limit wait and
+// exits
+func (m Model) quit() (Model, tea.Cmd) {
+	m.saveLastResults()
+	if m.scan != nil {
+		m.scan.control.Cancel()
+	}
+	if m.github != nil {
+		m.github.Close() // ends any wait for a rate limit to reset
+	}
+	return m, tea.Quit
+}
+
 func (m Model) handleBack() (Model, tea.Cmd) {
+	// Back first widens a label- or preset-filtered issue list to all issues again
+	if m.currentScreen == issueListScreen && m.issueFilterActive() {
+		return m.clearIssueFilter(), nil
+	}
+	if m.currentScreen == repoListScreen && m.topicFilter != "" {
+		return m.clearTopicFilter(), nil
+	}
+
+	if m.currentScreen != welcomeScreen && m.currentScreen != scanScreen {
+		m = m.pushForward()
+	}
+
 	switch m.currentScreen {
 	case repoListScreen:
 		m.currentScreen = welcomeScreen
 	case issueListScreen:
-		m.currentScreen = repoListScreen
+		// Lists opened without a search (watchlist, a repo opened by name)
+		// have no repository list to go back to
+		if len(m.repos) == 0 {
+			m.currentScreen = welcomeScreen
+		} else {
+			m.currentScreen = repoListScreen
+		}
 	case issueDetailScreen:
 		m.currentScreen = issueListScreen
+	case readmeScreen:
+		m.currentScreen = repoListScreen
+	case historyScreen, bookmarksScreen:
+		m.currentScreen = welcomeScreen
+	case completedScreen:
+		m.currentScreen = welcomeScreen
+	case scanScreen:
+		m.currentScreen = repoListScreen
+	case labelsScreen:
+		m.currentScreen = issueListScreen
+	case topicsScreen:
+		m.currentScreen = repoListScreen
 	}
 	return m, nil
 }
 
 func (m Model) handleEnter() (Model, tea.Cmd) {
 	switch m.currentScreen {
+	case labelsScreen:
+		return m.handleLabelSelect()
+
+	case topicsScreen:
+		return m.handleTopicSelect()
+
+	case historyScreen:
+		return m.handleHistorySelect()
+
+	case bookmarksScreen:
+		return m.handleBookmarkSelect()
+
+	case scanScreen:
+		if m.scan != nil {
+			return m.browseScan()
+		}
+
 	case welcomeScreen:
-		// Start loading repositories
+		// Ignore Enter while a search is already running
+		if m.loading {
+			return m, nil
+		}
+
+		// Start loading repositories from a fresh search
 		m.loading = true
+		m.relaxSteps = nil
+		m.relaxExhausted = false
+		m.relaxed = nil
+		m.pendingSearch = true
+		m.github.ClearRepoSearchCache()
 		return m, m.loadRepositories()
 
 	case repoListScreen:
 		if len(m.repoList.Items()) == 0 {
-			return m, nil
+			if !m.config.RetryOnEmptyEnter {
+				return m, nil
+			}
+
+			// Re-run the search as if refreshing
+			m, cmd := m.handleRefresh()
+			m.status = "No repositories to open, re-running the search..."
+			return m, cmd
 		}
 
 		// Get selected repository and open in browser
@@ -421,13 +1295,30 @@ func (m Model) handleEnter() (Model, tea.Cmd) {
 
 	case issueListScreen:
 		if len(m.issueList.Items()) == 0 {
-			return m, nil
+			if !m.config.RetryOnEmptyEnter || m.selectedRepo == nil {
+				return m, nil
+			}
+
+			// Broaden the label filter by showing excluded labels, or just
+			// reload if nothing is being excluded
+			m.status = "No issues to open, reloading..."
+			if m.excludeLabels && len(m.config.ExcludeIssueLabels) > 0 {
+				m.excludeLabels = false
+				m.status = "No issues to open, reloading with excluded labels included..."
+			}
+			m.loading = true
+			return m, m.loadIssues(m.selectedRepo)
 		}
 
 		// Get selected issue and open in browser
 		if selectedItem, ok := m.issueList.SelectedItem().(issueItem); ok {
 			issue := selectedItem.issue
 			if issue.Issue.HTMLURL != nil {
+				entry := history.Entry{Event: history.EventIssueOpened, IssueNumber: issue.Issue.GetNumber()}
+				if m.selectedRepo != nil {
+					entry.Repository = m.selectedRepo.GetFullName()
+				}
+				m.recordHistory(entry)
 				return m, m.openInBrowser(*issue.Issue.HTMLURL)
 			}
 		}
@@ -454,14 +1345,69 @@ func (m Model) handleIssues() (Model, tea.Cmd) {
 	return m, nil
 }
 
-func (m Model) handleRefresh() (Model, tea.Cmd) {
-	switch m.currentScreen {
-	case repoListScreen:
+func (m Model) handleReadme() (Model, tea.Cmd) {
+	if m.currentScreen != repoListScreen || len(m.repoList.Items()) == 0 {
+		return m, nil
+	}
+
+	// Get selected repository and load its README
+	if selectedItem, ok := m.repoList.SelectedItem().(repoItem); ok {
 		m.loading = true
-		return m, m.loadRepositoriesPage(m.currentPage)
-	case issueListScreen:
+		return m, m.loadReadme(selectedItem.repo)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleDetails() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen || len(m.issueList.Items()) == 0 {
+		return m, nil
+	}
+
+	// Get selected issue and lazily fetch its full details
+	if selectedItem, ok := m.issueList.SelectedItem().(issueItem); ok {
+		repo := m.selectedRepo
+		if selectedItem.issue.Repository != nil {
+			repo = selectedItem.issue.Repository // from a cross-repo scan
+		}
+		if repo == nil {
+			return m, nil
+		}
+		m.selectedRepo = repo
+		m.loading = true
+		return m, m.loadIssueDetail(repo, selectedItem.issue)
+	}
+
+	return m, nil
+}
+
+func (m Model) handleExcludeToggle() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen || m.selectedRepo == nil {
+		return m, nil
+	}
+
+	// Flip the exclude filter and reload so the list reflects it
+	m.excludeLabels = !m.excludeLabels
+	m.loading = true
+	return m, m.loadIssues(m.selectedRepo)
+}
+
+func (m Model) handleRefresh() (Model, tea.Cmd) {
+	switch m.currentScreen {
+	case repoListScreen:
+		m.loading = true
+		m.relaxExhausted = false
+		m.pendingSearch = true
+		m.github.ClearRepoSearchCache()
+		return m, m.loadRepositoriesPage(m.currentPage)
+	case issueListScreen:
+		if m.issuesSource == watchlistSource {
+			m.loading = true
+			return m, m.loadWatchlist()
+		}
 		if m.selectedRepo != nil {
 			m.loading = true
+			m.github.ClearRepositoryIssuesCache(m.selectedRepo.GetOwner().GetLogin(), m.selectedRepo.GetName())
 			return m, m.loadIssues(m.selectedRepo)
 		}
 	}
@@ -474,36 +1420,153 @@ func (m Model) loadRepositories() tea.Cmd {
 }
 
 func (m Model) loadRepositoriesPage(page int) tea.Cmd {
-	return m.loadRepositoriesPageWithDirection(page, true) // Default to first item
+	load := m.loadRepositoriesPageWithDirection(page, true) // Default to first item
+	return func() tea.Msg {
+		msg := load()
+		if loaded, ok := msg.(reposLoadedMsg); ok {
+			loaded.keepSelection = true
+			return loaded
+		}
+		return msg
+	}
 }
 
 func (m Model) loadRepositoriesPageWithDirection(page int, resetToFirst bool) tea.Cmd {
 	return func() tea.Msg {
 		logger.Info(fmt.Sprintf("Loading repositories page %d via CLI command - languages: %v, max: %d",
-			page, m.config.PreferredLanguages, m.config.MaxRepos))
+			page, m.activeCriteria().languages, m.pageSize()))
 
-		repos, total, err := m.github.SearchHacktoberfestReposWithPage(20, m.config.PreferredLanguages, m.config.MaxRepos, page)
+		result, err := m.github.SearchHacktoberfestReposWithPage(m.repoSearchOptions(), m.pageSize(), page)
 		if err != nil {
 			logger.ErrorWithErr("Repository loading failed in CLI", err)
 			return errorMsg{err: err}
 		}
 
-		// Check if there are more pages
-		hasMore := len(repos) == m.config.MaxRepos && (page*m.config.MaxRepos) < total
+		apiCalls := result.APICalls
+		var readiness map[*github.Repository]*github.Readiness
+		if m.config.CheckReadiness {
+			var calls int
+			readiness, calls = m.github.ReadinessOf(result.Repositories)
+			apiCalls += calls
+		}
+
+		// Check if there are more pages of ranked candidates
+		hasMore := page*m.pageSize() < result.CandidateCount
 
-		logger.Info(fmt.Sprintf("Repositories page %d loaded successfully in CLI: %d repos returned (global total ~%d), hasMore: %t",
-			page, len(repos), total, hasMore))
+		logger.Info(fmt.Sprintf("Repositories page %d loaded successfully in CLI: %d repos returned (%d candidates, global total ~%d), hasMore: %t",
+			page, len(result.Repositories), result.CandidateCount, result.TotalAvailable, hasMore))
 
 		return reposLoadedMsg{
-			repos:        repos,
-			totalRepoCnt: total,
+			repos:        result.Repositories,
+			totalRepoCnt: result.TotalAvailable,
+			candidateCnt: result.CandidateCount,
 			currentPage:  page,
 			hasMore:      hasMore,
 			resetToFirst: resetToFirst,
+			broadened:    result.Broadened,
+			incomplete:   result.Incomplete,
+			apiCalls:     apiCalls,
+			readiness:    readiness,
 		}
 	}
 }
 
+// apiCallsStatus reports how many API requests a load cost
+func apiCallsStatus(calls int) string {
+	switch calls {
+	case 0:
+		return "No API calls, served from the last search"
+	case 1:
+		return "Used 1 API call"
+	}
+	return fmt.Sprintf("Used %d API calls", calls)
+}
+
+// broadenedLanguages describes the languages a broadened search widened to
+func (m Model) broadenedLanguages() string {
+	if len(m.config.FallbackLanguages) == 0 {
+		return "any language"
+	}
+	return strings.Join(m.config.FallbackLanguages, ", ")
+}
+
+// searchCriteria are the repository search filters a re-run history search
+// or a filter preset's languages put in effect for the rest of the session.
+// Like relaxedFilters they are kept apart from the config so they're never
+// saved with it.
+type searchCriteria struct {
+	languages       []string
+	minStars        int
+	maxStars        int
+	minOpenIssues   int
+	ownerType       string
+	includeArchived bool
+}
+
+// activeCriteria returns the search filters in effect: those of a re-run
+// history search or preset if any, otherwise the configured ones
+func (m Model) activeCriteria() searchCriteria {
+	if m.criteria != nil {
+		return *m.criteria
+	}
+	return searchCriteria{
+		languages:       m.config.PreferredLanguages,
+		minStars:        m.config.MinStars,
+		maxStars:        m.config.MaxStars,
+		minOpenIssues:   m.config.MinOpenIssues,
+		ownerType:       m.config.OwnerType,
+		includeArchived: m.config.IncludeArchived,
+	}
+}
+
+// repoSearchOptions builds the repository search criteria from config
+func (m Model) repoSearchOptions() github.RepoSearchOptions {
+	criteria := m.activeCriteria()
+	opts := github.RepoSearchOptions{
+		MinStars:          criteria.minStars,
+		MaxStars:          criteria.maxStars,
+		Languages:         criteria.languages,
+		PinnedRepos:       m.config.PinnedRepos,
+		FetchPinned:       m.config.FetchPinnedRepos,
+		MinOpenIssues:     criteria.minOpenIssues,
+		Broaden:           m.config.BroadenSearch,
+		FallbackLanguages: m.config.FallbackLanguages,
+		Weights:           m.relevanceWeights(),
+		OwnerType:         criteria.ownerType,
+		DiscoveryMode:     m.config.DiscoveryMode,
+		IncludeArchived:   criteria.includeArchived,
+		Dependencies:      m.dependencies(),
+		Familiar:          m.familiarRepoBehavior() != "off",
+		PushedWithinDays:  m.config.PushedWithinDays,
+	}
+	if m.relaxed != nil {
+		opts.MinStars = m.relaxed.minStars
+		opts.Languages = m.relaxed.languages
+		opts.PushedWithinDays = m.relaxed.pushedWithin
+	}
+	return opts
+}
+
+// relevanceWeights builds the relevance score weights from config
+func (m Model) relevanceWeights() github.RelevanceWeights {
+	weights := github.RelevanceWeights{
+		Stars: github.StarScoring{
+			Cap:     m.config.StarScoreCap,
+			Divisor: m.config.StarScoreDivisor,
+		},
+		RecentBonus:     m.config.RecentActivityBonus,
+		LanguageBonus:   m.config.LanguageBonus,
+		DependencyBonus: m.config.DependencyBonus,
+	}
+	if m.config.PreferOrgs {
+		weights.OrgBonus = github.DefaultOrgBonus
+	}
+	if m.familiarRepoBehavior() == "boost" {
+		weights.FamiliarBonus = m.config.FamiliarBonus
+	}
+	return weights
+}
+
 func (m Model) loadIssues(repo *github.Repository) tea.Cmd {
 	return func() tea.Msg {
 		repoName := fmt.Sprintf("%s/%s", *repo.Repository.Owner.Login, *repo.Repository.Name)
@@ -514,8 +1577,9 @@ func (m Model) loadIssues(repo *github.Repository) tea.Cmd {
 		issueStats, err := m.github.GetRepositoryIssues(
 			*repo.Repository.Owner.Login,
 			*repo.Repository.Name,
-			[]string{"hacktoberfest"},
+			m.config.IssueLabels,
 			m.config.MaxIssuesPerRepo,
+			m.issueFilter(),
 		)
 		if err != nil {
 			logger.ErrorWithErr("Issue loading failed in CLI", err)
@@ -525,8 +1589,241 @@ func (m Model) loadIssues(repo *github.Repository) tea.Cmd {
 		logger.Info(fmt.Sprintf("Issues loaded successfully for %s: %d issues found with %d unique labels",
 			repoName, issueStats.TotalIssues, len(issueStats.LabelCounts)))
 
-		return issuesLoadedMsg{issues: issueStats.Issues, labelStats: issueStats.LabelCounts}
+		if m.config.SortByFirstResponse {
+			issueStats.APICalls += m.github.EnrichFirstResponses(
+				*repo.Repository.Owner.Login,
+				*repo.Repository.Name,
+				issueStats.Issues,
+			)
+		}
+
+		return issuesLoadedMsg{
+			source:         repoKey(repo),
+			issues:         issueStats.Issues,
+			labelStats:     issueStats.LabelCounts,
+			excludedCount:  issueStats.ExcludedCount,
+			takenCount:     issueStats.TakenCount,
+			discussedCount: issueStats.DiscussedCount,
+			commentedCount: issueStats.CommentedCount,
+			assignedCount:  issueStats.AssignedCount,
+			apiCalls:       issueStats.APICalls,
+		}
+	}
+}
+
+// issueFilter builds the client-side issue filter from config and runtime toggles
+func (m Model) issueFilter() github.IssueFilter {
+	filter := github.IssueFilter{}
+	if m.excludeLabels {
+		filter.ExcludeLabels = m.config.ExcludeIssueLabels
+	}
+	filter.ExcludeWithOpenPRs = m.config.HideIssuesWithOpenPRs
+	filter.ExcludeAssigned = m.config.HideAssignedIssues
+	filter.MaxComments = m.config.MaxCommentsBeforeSkip
+	// Issues you commented on come back, badged, when x shows everything
+	filter.MarkCommented = m.config.HideMyCommentedIssues
+	filter.ExcludeCommented = m.config.HideMyCommentedIssues && m.excludeLabels
+	filter.Sort = m.config.IssueFetchSort
+	filter.Direction = m.config.IssueFetchDirection
+	filter.LabelMatch = m.config.IssueLabelMatchMode
+	return filter
+}
+
+func (m Model) loadIssueDetail(repo *github.Repository, issue *github.Issue) tea.Cmd {
+	return func() tea.Msg {
+		logger.Info(fmt.Sprintf("Loading issue detail for #%d via CLI command", *issue.Issue.Number))
+
+		detail, err := m.github.GetIssueDetail(
+			*repo.Repository.Owner.Login,
+			*repo.Repository.Name,
+			*issue.Issue.Number,
+		)
+		if err != nil {
+			logger.ErrorWithErr("Issue detail loading failed in CLI", err)
+			return errorMsg{err: err}
+		}
+
+		// Already cached when the list was sorted by first response
+		if m.config.ShowFirstResponse || m.config.SortByFirstResponse {
+			m.github.EnrichFirstResponses(
+				*repo.Repository.Owner.Login,
+				*repo.Repository.Name,
+				[]*github.Issue{detail},
+			)
+		}
+
+		// Linked PRs, projects and timeline activity are extra context, so a
+		// failure here doesn't block the detail view
+		timeline, err := m.github.GetIssueTimeline(
+			*repo.Repository.Owner.Login,
+			*repo.Repository.Name,
+			*issue.Issue.Number,
+		)
+		if err != nil {
+			logger.ErrorWithErr("Issue timeline loading failed in CLI", err)
+			timeline = &github.IssueTimeline{}
+		}
+
+		return issueSelectedMsg{issue: detail, linkedPRs: timeline.LinkedPRs, projects: timeline.Projects, activity: timeline.Events}
+	}
+}
+
+func (m Model) loadReadme(repo *github.Repository) tea.Cmd {
+	return func() tea.Msg {
+		repoName := fmt.Sprintf("%s/%s", *repo.Repository.Owner.Login, *repo.Repository.Name)
+		logger.Info(fmt.Sprintf("Loading README for %s via CLI command", repoName))
+
+		content, err := m.github.GetRepositoryReadme(
+			*repo.Repository.Owner.Login,
+			*repo.Repository.Name,
+			readmeExcerptLines,
+		)
+		if err != nil {
+			logger.ErrorWithErr("README loading failed in CLI", err)
+			return readmeFailedMsg{repo: repo, err: err}
+		}
+
+		msg := readmeLoadedMsg{repo: repo, content: content}
+		if m.config.ShowActivity {
+			// The sparkline is a nice-to-have, so the README shows without it
+			activity, err := m.github.GetCommitActivity(*repo.Repository.Owner.Login, *repo.Repository.Name)
+			if err != nil {
+				logger.ErrorWithErr("Commit activity loading failed in CLI", err)
+			}
+			msg.commitActivity = activity
+		}
+		return msg
+	}
+}
+
+func (m Model) loadHistory() tea.Cmd {
+	return func() tea.Msg {
+		entries, err := history.Load()
+		if err != nil {
+			logger.ErrorWithErr("Failed to load search history", err)
+			return errorMsg{err: err}
+		}
+
+		return historyLoadedMsg{summaries: history.Summarize(entries)}
+	}
+}
+
+// repoKey returns the "owner/name" identity of a repository
+func repoKey(repo *github.Repository) string {
+	return fmt.Sprintf("%s/%s", repo.Repository.GetOwner().GetLogin(), repo.Repository.GetName())
+}
+
+// starRange describes a star range for display, e.g. "20–500", "≥20" or "any"
+func starRange(minStars, maxStars int) string {
+	switch {
+	case minStars > 0 && maxStars > 0:
+		return fmt.Sprintf("%d–%d", minStars, maxStars)
+	case minStars > 0:
+		return fmt.Sprintf("≥%d", minStars)
+	case maxStars > 0:
+		return fmt.Sprintf("≤%d", maxStars)
+	}
+	return "any"
+}
+
+// formatStars returns the repository's star count, or "—" when search
+// results didn't include it
+func formatStars(repo *github.Repository) string {
+	if repo.Repository.StargazersCount == nil {
+		return "—"
+	}
+	return fmt.Sprintf("%d", repo.Repository.GetStargazersCount())
+}
+
+// indexOfRepo returns the index of the repository with the given identity, or -1
+func indexOfRepo(repos []*github.Repository, key string) int {
+	if key == "" {
+		return -1
+	}
+	for i, repo := range repos {
+		if repoKey(repo) == key {
+			return i
+		}
+	}
+	return -1
+}
+
+// indexOfIssue returns the index of the issue with the given number, or -1
+func indexOfIssue(issues []*github.Issue, number int) int {
+	if number == 0 {
+		return -1
+	}
+	for i, issue := range issues {
+		if issue.Issue.GetNumber() == number {
+			return i
+		}
+	}
+	return -1
+}
+
+// recordVisit stores the current time as the last visit to the selected
+// repository and returns the previous visit. Within a session the first
+// previous visit is kept, so refreshing doesn't clear the badges.
+func (m Model) recordVisit() time.Time {
+	if m.selectedRepo == nil {
+		return time.Time{}
+	}
+
+	repoKey := m.selectedRepo.GetFullName()
+	if baseline, ok := m.visitBaselines[repoKey]; ok {
+		return baseline
+	}
+
+	baseline := m.store.LastVisits[repoKey]
+	m.visitBaselines[repoKey] = baseline
+	m.store.LastVisits[repoKey] = time.Now()
+	if err := m.store.Save(); err != nil {
+		logger.ErrorWithErr("Failed to save last visit", err)
+	}
+
+	return baseline
+}
+
+// visitBadge returns "NEW" or "UPDATED" for issues created or updated since
+// lastVisit, or an empty string on a first visit or for unchanged issues
+func visitBadge(issue *github.Issue, lastVisit time.Time) string {
+	switch {
+	case lastVisit.IsZero():
+		return ""
+	case issue.Issue.GetCreatedAt().After(lastVisit):
+		return "NEW"
+	case issue.Issue.GetUpdatedAt().After(lastVisit):
+		return "UPDATED"
 	}
+	return ""
+}
+
+// recordHistory appends an entry to the user's search history file. Failures
+// are logged but never interrupt browsing.
+func (m Model) recordHistory(entry history.Entry) {
+	if err := history.Append(entry); err != nil {
+		logger.ErrorWithErr("Failed to record search history", err)
+	}
+}
+
+// handleOpenInBrowser opens the selected repository, the selected issue or
+// the open issue in the browser, doing nothing when nothing is selected
+func (m Model) handleOpenInBrowser() (Model, tea.Cmd) {
+	url := ""
+	switch m.currentScreen {
+	case repoListScreen:
+		if item, ok := m.repoList.SelectedItem().(repoItem); ok {
+			url = item.repo.Repository.GetHTMLURL()
+		}
+	case issueListScreen, issueDetailScreen:
+		if issue := m.completionTarget(); issue != nil {
+			url = issue.Issue.GetHTMLURL()
+		}
+	}
+	if url == "" {
+		return m, nil
+	}
+	return m, m.openInBrowser(url)
 }
 
 func (m Model) openInBrowser(url string) tea.Cmd {
@@ -543,20 +1840,24 @@ func (m Model) openInBrowser(url string) tea.Cmd {
 			cmd = exec.Command("rundll32", "url.dll,FileProtocolHandler", url)
 		default:
 			logger.Info(fmt.Sprintf("Unsupported operating system for browser opening: %s", runtime.GOOS))
-			return nil
+			return browserFailedMsg{err: fmt.Errorf("unsupported operating system %s", runtime.GOOS)}
 		}
 
 		if err := cmd.Start(); err != nil {
 			logger.ErrorWithErr("Failed to open browser", err)
-		} else {
-			logger.Info("Successfully opened URL in browser")
+			return browserFailedMsg{err: err}
 		}
+		logger.Info("Successfully opened URL in browser")
 
 		return nil
 	}
 }
 
 func (m Model) View() string {
+	if m.config.AccessibleMode {
+		return m.accessibleView()
+	}
+
 	if m.width == 0 {
 		return "Loading..."
 	}
@@ -570,6 +1871,22 @@ func (m Model) View() string {
 		return m.issueListView()
 	case issueDetailScreen:
 		return m.issueDetailView()
+	case readmeScreen:
+		return m.readmeScreenView()
+	case historyScreen:
+		return m.historyScreenView()
+	case bookmarksScreen:
+		return m.bookmarksScreenView()
+	case scanScreen:
+		return m.scanView()
+	case completedScreen:
+		return m.completedScreenView()
+	case tunerScreen:
+		return m.tunerView()
+	case labelsScreen:
+		return m.labelsScreenView()
+	case topicsScreen:
+		return m.topicsScreenView()
 	}
 
 	return "Unknown screen"
@@ -577,10 +1894,11 @@ func (m Model) View() string {
 
 func (m Model) welcomeView() string {
 	if m.loading {
+		criteria := m.activeCriteria()
 		return lipgloss.JoinVertical(lipgloss.Left,
 			RenderHeader("Searching Repositories"),
 			"",
-			RenderStatus("Searching for Hacktoberfest repositories..."),
+			RenderStatus(fmt.Sprintf("Searching for Hacktoberfest repositories with %s stars...", starRange(criteria.minStars, criteria.maxStars))),
 			RenderStatus("This may take a few moments..."),
 			"",
 			MetaStyle.Render("Press Ctrl+C to cancel"),
@@ -597,22 +1915,95 @@ func (m Model) welcomeView() string {
 		RenderSubHeader("Your Configuration"),
 		ContentStyle.Render(fmt.Sprintf("Languages: %s", strings.Join(m.config.PreferredLanguages, ", "))),
 		ContentStyle.Render(fmt.Sprintf("Skill Level: %s", m.config.SkillLevel)),
+		ContentStyle.Render(fmt.Sprintf("Stars: %s", starRange(m.config.MinStars, m.config.MaxStars))),
 		ContentStyle.Render(fmt.Sprintf("Max Repositories: %d", m.config.MaxRepos)),
 		"",
 		SuccessStyle.Render("Press ENTER to start searching for repositories!"),
-		"",
-		FooterStyle.Render("Enter: Start • Ctrl+C: Quit"),
 	}
 
+	if m.confirmReset {
+		content = append(content, "", ErrorStyle.Render(resetPrompt))
+	} else if m.promptRepo {
+		content = append(content, "", m.repoPromptView())
+	}
+	if m.status != "" && !m.confirmReset {
+		content = append(content, "", RenderStatus(m.status))
+	}
+
+	if m.error != nil {
+		content = append(content, "", RenderError(m.error.Error()))
+	}
+
+	content = append(content, "", m.renderFooter(
+		keyHint("Start", m.keys.Enter),
+		keyHint("Open a repo", m.keys.Open),
+		keyHint("Watchlist", m.keys.Watch),
+		keyHint("Search history", m.keys.History),
+		keyHint("Completed issues", m.keys.Completed),
+		keyHint("Bookmarks", m.keys.Bookmark),
+		keyHint("Reset settings", m.keys.Reset),
+		keyHint("Quit", m.keys.Quit),
+	))
+
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
 
+// pageSize returns how many repositories a page holds: the size picked this
+// session if any, else Config.MaxRepos, falling back to the default when that
+// isn't positive
+func (m Model) pageSize() int {
+	if m.perPage > 0 {
+		return m.perPage
+	}
+	if m.config.MaxRepos <= 0 {
+		return config.DefaultConfig().MaxRepos
+	}
+	return m.config.MaxRepos
+}
+
+// totalPages returns how many pages of perPage items count items fill. An
+// empty list still has its one (empty) page, and a page size below one is
+// treated as one item per page instead of dividing by zero.
+func totalPages(count, perPage int) int {
+	if count <= 0 {
+		return 1
+	}
+	return (count + max(perPage, 1) - 1) / max(perPage, 1) // ceil division
+}
+
 func (m Model) repoListView() string {
 	if m.loading {
 		return lipgloss.JoinVertical(lipgloss.Left,
 			RenderHeader("Loading Repositories"),
 			"",
 			RenderStatus("Please wait..."),
+			MetaStyle.Render(m.status),
+		)
+	}
+
+	var invalid *github.InvalidQueryError
+	if errors.As(m.error, &invalid) {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			RenderHeader("Invalid Search"),
+			"",
+			RenderError(invalid.Error()),
+			MetaStyle.Render("Query: "+invalid.Query),
+			"",
+			RenderStatus("Check preferred_languages and min_stars in your config, then retry."),
+			"",
+			m.renderFooter(keyHint("Back", m.keys.Back), keyHint("Retry", m.keys.Refresh)),
+		)
+	}
+
+	if errors.Is(m.error, github.ErrRateLimited) {
+		return lipgloss.JoinVertical(lipgloss.Left,
+			RenderHeader("Rate Limited"),
+			"",
+			RenderError(fmt.Sprintf("Failed to load repositories: %v", m.error)),
+			"",
+			RenderStatus("Wait for the limit to reset, or set github_token for a higher limit, then retry."),
+			"",
+			m.renderFooter(keyHint("Back", m.keys.Back), keyHint("Retry", m.keys.Refresh)),
 		)
 	}
 
@@ -625,19 +2016,27 @@ func (m Model) repoListView() string {
 			RenderStatus("Check logs for details:"),
 			MetaStyle.Render(logger.GetLogLocation()),
 			"",
-			FooterStyle.Render("Q: Back • R: Retry"),
+			m.renderFooter(keyHint("Back", m.keys.Back), keyHint("Retry", m.keys.Refresh)),
 		)
 	}
 
 	if len(m.repos) == 0 {
-		return lipgloss.JoinVertical(lipgloss.Left,
+		content := []string{
 			RenderHeader("No Repositories Found"),
 			"",
 			RenderError("No repositories found matching your criteria."),
-			RenderStatus("Try adjusting your language preferences."),
-			"",
-			FooterStyle.Render("Q: Back • R: Refresh"),
-		)
+		}
+		for _, suggestion := range m.emptyRepoSuggestions() {
+			content = append(content, RenderStatus(suggestion))
+		}
+		hints := []string{keyHint("Relax filters and retry", m.keys.Relax)}
+		if m.relaxExhausted {
+			content = append(content, "", RenderError("All filters relaxed and still no results: "+strings.Join(m.relaxSteps, ", ")))
+			hints = nil
+		}
+		hints = append(hints, keyHint("Search again", m.keys.Enter, m.keys.Refresh), keyHint("Back", m.keys.Back))
+		content = append(content, "", m.renderFooter(hints...))
+		return lipgloss.JoinVertical(lipgloss.Left, content...)
 	}
 
 	// m.repoList.Title already updated with counts; add pagination info and controls
@@ -647,21 +2046,100 @@ func (m Model) repoListView() string {
 	var controls []string
 
 	// Add current page info
-	totalPages := (m.totalRepos + m.config.MaxRepos - 1) / m.config.MaxRepos // ceil division
-	controls = append(controls, fmt.Sprintf("Page %d/%d", m.currentPage, totalPages))
+	controls = append(controls, fmt.Sprintf("Page %d/%d", m.currentPage, totalPages(m.candidateCnt, m.pageSize())))
 
-	if m.currentPage > 1 {
-		controls = append(controls, "← Previous (left)")
-	}
-	if m.hasMorePages {
-		controls = append(controls, "Next → (right)")
+	if m.config.ShowFooter {
+		if m.currentPage > 1 {
+			controls = append(controls, keyHint("Previous page", m.keys.Left))
+		}
+		if m.hasMorePages {
+			controls = append(controls, keyHint("Next page", m.keys.Right))
+		}
+		controls = append(controls,
+			keyHint("Open in browser", m.keys.Enter),
+			keyHint("View issues", m.keys.Issues),
+			keyHint("Scan good first issues", m.keys.Scan),
+			keyHint("README", m.keys.Readme),
+			keyHint("Filter", m.repoList.KeyMap.Filter),
+			keyHint("Page size", m.keys.MoreRepos, m.keys.FewerRepos),
+			keyHint("Topics", m.keys.Labels),
+			keyHint("Export CSV", m.keys.Export),
+			keyHint("Refresh", m.keys.Refresh),
+			keyHint("Back", m.keys.Back),
+		)
 	}
-	controls = append(controls, "Enter: Open in browser", "I: View issues", "Type to filter", "R: Refresh", "Q: Back")
 
 	controlText := strings.Join(controls, " • ")
 	info := MetaStyle.Render(controlText)
 
-	return lipgloss.JoinVertical(lipgloss.Left, listView, info)
+	sections := []string{listView}
+	if m.topicFilter != "" {
+		sections = append(sections, LabelStyle.Render(fmt.Sprintf("Showing only repositories tagged %q • %s", m.topicFilter, keyHint("Show all", m.keys.Back))))
+	}
+	if item, ok := m.repoList.SelectedItem().(repoItem); ok {
+		sections = append(sections, MetaStyle.Render("Why: "+item.repo.ExplainRelevance()))
+	}
+	if len(m.relaxSteps) > 0 {
+		sections = append(sections, MetaStyle.Render("Relaxed filters: "+strings.Join(m.relaxSteps, ", ")))
+	}
+	if !m.cachedAt.IsZero() {
+		sections = append(sections, MetaStyle.Render(fmt.Sprintf("Cached results from %s, refreshing...", m.cachedAt.Format("Jan 2 15:04"))))
+	}
+	if m.scan != nil {
+		sections = append(sections, MetaStyle.Render(fmt.Sprintf("Good first issue scan %s • %s", m.scanStatus(), keyHint("View", m.keys.Scan))))
+	}
+	if m.incomplete {
+		sections = append(sections, MetaStyle.Render("GitHub timed out on the search and returned only part of the results • "+keyHint("Retry", m.keys.Refresh)))
+	}
+	if m.broadened {
+		sections = append(sections, MetaStyle.Render("Broadened search: too few results for your languages, so repos marked "+icons.Broadened+" come from "+m.broadenedLanguages()))
+	}
+	if m.status != "" {
+		sections = append(sections, RenderStatus(m.status))
+	}
+	sections = append(sections, info)
+
+	return lipgloss.JoinVertical(lipgloss.Left, sections...)
+}
+
+// emptyRepoSuggestions inspects the active search filters and suggests which
+// ones most likely caused an empty result set
+func (m Model) emptyRepoSuggestions() []string {
+	var suggestions []string
+	defaults := config.DefaultConfig()
+	criteria := m.activeCriteria()
+
+	if criteria.minStars > defaults.MinStars {
+		suggestions = append(suggestions, fmt.Sprintf("Try lowering MinStars from %d (default is %d).",
+			criteria.minStars, defaults.MinStars))
+	}
+
+	if criteria.maxStars > 0 {
+		suggestions = append(suggestions, fmt.Sprintf("Try raising MaxStars from %d or setting it to 0 for no upper limit.",
+			criteria.maxStars))
+	}
+
+	if criteria.minOpenIssues > defaults.MinOpenIssues {
+		suggestions = append(suggestions, fmt.Sprintf("Try lowering MinOpenIssues from %d (default is %d).",
+			criteria.minOpenIssues, defaults.MinOpenIssues))
+	}
+
+	switch len(criteria.languages) {
+	case 0:
+		// No language filter applied, nothing to suggest here
+	case 1:
+		suggestions = append(suggestions, fmt.Sprintf("No repos found for language '%s'. Try adding more languages.",
+			criteria.languages[0]))
+	default:
+		suggestions = append(suggestions, fmt.Sprintf("No repos found for languages: %s. Try adding more languages.",
+			strings.Join(criteria.languages, ", ")))
+	}
+
+	if len(suggestions) == 0 {
+		suggestions = append(suggestions, "Check if Hacktoberfest is currently active.")
+	}
+
+	return suggestions
 }
 
 func (m Model) issueListView() string {
@@ -670,6 +2148,7 @@ func (m Model) issueListView() string {
 			RenderHeader("Loading Issues"),
 			"",
 			RenderStatus("Please wait..."),
+			MetaStyle.Render(m.status),
 		)
 	}
 
@@ -682,7 +2161,7 @@ func (m Model) issueListView() string {
 			RenderStatus("Check logs for details:"),
 			MetaStyle.Render(logger.GetLogLocation()),
 			"",
-			FooterStyle.Render("Q: Back • R: Retry"),
+			m.renderFooter(keyHint("Back", m.keys.Back), keyHint("Retry", m.keys.Refresh)),
 		)
 	}
 
@@ -693,7 +2172,7 @@ func (m Model) issueListView() string {
 			RenderError("No open issues found in this repository."),
 			RenderStatus("This repository might not have any open issues."),
 			"",
-			FooterStyle.Render("Q: Back • R: Refresh"),
+			m.renderFooter(keyHint("Retry", m.keys.Enter), keyHint("Back", m.keys.Back), keyHint("Refresh", m.keys.Refresh)),
 		)
 	}
 
@@ -704,6 +2183,9 @@ func (m Model) issueListView() string {
 	}
 
 	header := RenderHeader(fmt.Sprintf("Issues in %s", repoName))
+	if m.issuesTitle != "" {
+		header = RenderHeader(m.issuesTitle)
+	}
 
 	// Build label statistics display
 	var labelLines []string
@@ -711,44 +2193,79 @@ func (m Model) issueListView() string {
 		labelLines = append(labelLines, RenderStatus(fmt.Sprintf("Found %d issues with %d unique labels:",
 			len(m.issues), len(m.labelStats))))
 
-		// Sort labels by count for better display
-		type labelCount struct {
-			name  string
-			count int
-		}
-		var sortedLabels []labelCount
-		for label, count := range m.labelStats {
-			sortedLabels = append(sortedLabels, labelCount{label, count})
-		}
-
-		// Sort by count (descending)
-		for i := 0; i < len(sortedLabels)-1; i++ {
-			for j := i + 1; j < len(sortedLabels); j++ {
-				if sortedLabels[i].count < sortedLabels[j].count {
-					sortedLabels[i], sortedLabels[j] = sortedLabels[j], sortedLabels[i]
-				}
-			}
-		}
-
-		// Show top 10 labels
-		maxLabels := len(sortedLabels)
-		if maxLabels > 10 {
-			maxLabels = 10
-		}
+		sortedLabels := sortLabelStats(m.labelStats, m.config.LabelStatsSort)
+		shown := sortedLabels[:min(m.labelStatsLimit(), len(sortedLabels))]
 
 		var labelStrs []string
-		for i := 0; i < maxLabels; i++ {
-			labelStrs = append(labelStrs, fmt.Sprintf("%s (%d)", sortedLabels[i].name, sortedLabels[i].count))
+		for _, label := range shown {
+			labelStrs = append(labelStrs, fmt.Sprintf("%s (%d)", label.name, label.count))
 		}
 
 		labelLines = append(labelLines, MetaStyle.Render(strings.Join(labelStrs, " • ")))
-		if len(sortedLabels) > 10 {
-			labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("... and %d more labels", len(sortedLabels)-10)))
+		if hidden := len(sortedLabels) - len(shown); hidden > 0 {
+			labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("... and %d more labels", hidden)))
 		}
 	} else {
 		labelLines = append(labelLines, RenderStatus(fmt.Sprintf("Found %d issues", len(m.issues))))
 	}
 
+	if m.excludeLabels && len(m.config.ExcludeIssueLabels) > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden by excluded labels (%s) • %s",
+			m.excludedCount, strings.Join(m.config.ExcludeIssueLabels, ", "), keyHint("Show all", m.keys.Exclude))))
+	} else if len(m.config.ExcludeIssueLabels) > 0 {
+		labelLines = append(labelLines, MetaStyle.Render("Excluded labels shown • "+keyHint("Hide them", m.keys.Exclude)))
+	}
+
+	if m.issuesNote != "" {
+		labelLines = append(labelLines, RenderError(m.issuesNote))
+	}
+
+	if m.takenCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden with an open linked PR", m.takenCount)))
+	}
+
+	if m.assignedCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden as assigned", m.assignedCount)))
+	}
+
+	if m.commentedCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden as you commented on them • %s", m.commentedCount, keyHint("Show all", m.keys.Exclude))))
+	}
+
+	if m.labelFilter != "" {
+		labelLines = append(labelLines, LabelStyle.Render(fmt.Sprintf("Showing only issues labelled %q • %s", m.labelFilter, keyHint("Show all", m.keys.Back))))
+	} else if m.typeFilter != "" {
+		labelLines = append(labelLines, LabelStyle.Render(fmt.Sprintf("Showing only %s issues • %s • %s", m.typeFilter, keyHint("Next type", m.keys.Kind), keyHint("Show all", m.keys.Back))))
+	} else if m.preset != nil {
+		labelLines = append(labelLines, LabelStyle.Render(fmt.Sprintf("Preset %q: showing only %s • %s", m.preset.Name, presetDescription(m.preset), keyHint("Show all", m.keys.Back))))
+	}
+
+	if m.snoozedCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d snoozed", m.snoozedCount)))
+	}
+
+	if m.seenCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden as seen", m.seenCount)))
+	}
+
+	if m.unknownCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden without labels or comments to judge difficulty by", m.unknownCount)))
+	}
+
+	if m.discussedCount > 0 {
+		labelLines = append(labelLines, MetaStyle.Render(fmt.Sprintf("%d hidden with more than %d comments", m.discussedCount, m.config.MaxCommentsBeforeSkip)))
+	}
+
+	if m.confirmExport {
+		labelLines = append(labelLines, ErrorStyle.Render(exportPrompt))
+	} else if m.status != "" {
+		labelLines = append(labelLines, RenderStatus(m.status))
+	}
+
+	if m.prTarget != nil {
+		labelLines = append(labelLines, m.prPromptView())
+	}
+
 	return lipgloss.JoinVertical(lipgloss.Left,
 		header,
 		"",
@@ -779,51 +2296,80 @@ func (m Model) issueDetailView() string {
 		RenderSubHeader("Details"),
 	}
 
-	// Author
-	content = append(content, ContentStyle.Render(fmt.Sprintf("Author: %s", *issue.Issue.User.Login)))
-
-	// Created date
-	content = append(content, ContentStyle.Render(fmt.Sprintf("Created: %s",
-		issue.Issue.CreatedAt.Format("January 2, 2006 at 15:04"))))
-
-	// Comments
-	if issue.Issue.Comments != nil {
-		content = append(content, ContentStyle.Render(fmt.Sprintf("Comments: %d", *issue.Issue.Comments)))
+	for _, field := range m.detailFields {
+		content = append(content, m.renderDetailField(field, issue)...)
 	}
 
-	// Difficulty
-	difficulty := RenderDifficulty(issue.DifficultyScore)
-	content = append(content, ContentStyle.Render(fmt.Sprintf("Difficulty: %s (%d/100)",
-		difficulty, issue.DifficultyScore)))
-
-	// Labels
-	if len(issue.Issue.Labels) > 0 {
-		labels := []string{}
-		for _, label := range issue.Issue.Labels {
-			labels = append(labels, *label.Name)
-		}
-		content = append(content, ContentStyle.Render(fmt.Sprintf("Labels: %s",
-			strings.Join(labels, ", "))))
+	content = append(content, "")
+	if m.prTarget != nil {
+		content = append(content, m.prPromptView())
+	} else if m.status != "" {
+		content = append(content, RenderStatus(m.status))
 	}
+	content = append(content, m.renderFooter(
+		keyHint("Mark completed", m.keys.Complete),
+		keyHint("Snooze", m.keys.Snooze),
+		keyHint("Open in browser", m.keys.Open),
+		keyHint("Bookmark", m.keys.Bookmark),
+		keyHint("Copy card", m.keys.Share),
+		keyHint("Back", m.keys.Back),
+	))
 
-	// URL
-	content = append(content, ContentStyle.Render(fmt.Sprintf("URL: %s", *issue.Issue.HTMLURL)))
-	content = append(content, "")
+	return lipgloss.JoinVertical(lipgloss.Left, content...)
+}
 
-	// Description
-	if issue.Issue.Body != nil && *issue.Issue.Body != "" {
-		content = append(content, RenderSubHeader("Description"))
+func (m Model) readmeScreenView() string {
+	repoName := "Unknown"
+	if m.selectedRepo != nil {
+		repoName = fmt.Sprintf("%s/%s", *m.selectedRepo.Owner.Login, *m.selectedRepo.Name)
+	}
 
-		// Wrap the body text
-		body := *issue.Issue.Body
-		if len(body) > 500 {
-			body = body[:500] + "..."
-		}
-		content = append(content, DescriptionStyle.Render(body))
+	activity := ""
+	if len(m.commitActivity) > 0 {
+		activity = MetaStyle.Render(fmt.Sprintf("Commits, last %d weeks: ", len(m.commitActivity))) +
+			SuccessStyle.UnsetPadding().Render(RenderSparkline(m.commitActivity)) +
+			MetaStyle.Render(fmt.Sprintf(" %d total", sumInts(m.commitActivity)))
 	}
 
-	content = append(content, "")
-	content = append(content, FooterStyle.Render("Q: Back"))
+	return lipgloss.JoinVertical(lipgloss.Left,
+		RenderHeader(fmt.Sprintf("README: %s", repoName)),
+		activity,
+		m.readmeView.View(),
+		m.renderFooter(keyHint(fmt.Sprintf("Scroll (%3.f%%)", m.readmeView.ScrollPercent()*100), m.keys.Up, m.keys.Down), keyHint("Back", m.keys.Back)),
+	)
+}
 
+func (m Model) bookmarksScreenView() string {
+	body := m.bookmarksList.View()
+	if len(m.bookmarksList.Items()) == 0 {
+		body = RenderStatus("No bookmarks yet. Press B on an issue to bookmark it.")
+	}
+	content := []string{body, MetaStyle.Render(bookmarks.GetBookmarksLocation())}
+	if m.status != "" {
+		content = append(content, RenderStatus(m.status))
+	}
+	content = append(content, m.renderFooter(
+		keyHint("Filter", m.bookmarksList.KeyMap.Filter),
+		keyHint("Open in browser", m.keys.Enter),
+		keyHint("Remove", m.keys.Bookmark),
+		keyHint("Export task list", m.keys.Export),
+		keyHint("Back", m.keys.Back),
+	))
 	return lipgloss.JoinVertical(lipgloss.Left, content...)
 }
+
+func (m Model) historyScreenView() string {
+	body := m.historyList.View()
+	if len(m.historyList.Items()) == 0 {
+		body = RenderStatus("No searches recorded yet.")
+	}
+	return lipgloss.JoinVertical(lipgloss.Left,
+		body,
+		MetaStyle.Render(history.GetHistoryLocation()),
+		m.renderFooter(
+			keyHint("Filter", m.historyList.KeyMap.Filter),
+			keyHint("Run this search again", m.keys.Enter),
+			keyHint("Back", m.keys.Back),
+		),
+	)
+}
@@ -0,0 +1,601 @@
+package cli
+
+import (
+	"fmt"
+	"os"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+	gh "github.com/google/go-github/v56/github"
+
+	"hacktober/internal/bookmarks"
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+	"hacktober/internal/history"
+)
+
+func TestRepoItemWithoutStargazerData(t *testing.T) {
+	repo := &github.Repository{Repository: &gh.Repository{
+		Owner: &gh.User{Login: gh.String("octo")},
+		Name:  gh.String("widgets"),
+	}}
+	item := repoItem{repo: repo}
+
+	if got := item.Title(); got != "octo/widgets" {
+		t.Errorf("Title() = %q, want %q", got, "octo/widgets")
+	}
+	if got := item.FilterValue(); !strings.HasPrefix(got, "octo/widgets") {
+		t.Errorf("FilterValue() = %q, want prefix %q", got, "octo/widgets")
+	}
+	if got := item.Description(); !strings.Contains(got, "⭐ —") {
+		t.Errorf("Description() = %q, want unknown stars shown as %q", got, "⭐ —")
+	}
+}
+
+func TestRepoItemFilterValueWithoutDescription(t *testing.T) {
+	repo := &github.Repository{Repository: &gh.Repository{
+		Owner:       &gh.User{Login: gh.String("octo")},
+		Name:        gh.String("widgets"),
+		Description: nil,
+	}}
+
+	if got := (repoItem{repo: repo}).FilterValue(); got != "octo/widgets" {
+		t.Errorf("FilterValue() = %q, want %q", got, "octo/widgets")
+	}
+
+	repo.Repository.Description = gh.String("Widgets for everyone")
+	if got := (repoItem{repo: repo}).FilterValue(); got != "octo/widgets Widgets for everyone" {
+		t.Errorf("FilterValue() = %q, want %q", got, "octo/widgets Widgets for everyone")
+	}
+}
+
+func TestRepoItemWithStargazerData(t *testing.T) {
+	repo := &github.Repository{Repository: &gh.Repository{
+		Owner:           &gh.User{Login: gh.String("octo")},
+		Name:            gh.String("widgets"),
+		StargazersCount: gh.Int(42),
+	}}
+
+	if got := (repoItem{repo: repo}).Description(); !strings.Contains(got, "⭐ 42") {
+		t.Errorf("Description() = %q, want it to contain %q", got, "⭐ 42")
+	}
+}
+
+func TestAutoAdvanceAfterClaimSelectsNextUnclaimedIssue(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.AutoAdvanceAfterClaim = true
+	m := NewModel(cfg)
+	issue := func(number int, assignees ...string) *github.Issue {
+		var users []*gh.User
+		for _, login := range assignees {
+			users = append(users, &gh.User{Login: gh.String(login)})
+		}
+		return &github.Issue{Issue: &gh.Issue{
+			Number:        gh.Int(number),
+			RepositoryURL: gh.String("https://api.github.com/repos/octo/docs"),
+			Assignees:     users,
+		}}
+	}
+	issues := []*github.Issue{issue(1), issue(2, "someone"), issue(3)}
+	var items []list.Item
+	for _, issue := range issues {
+		items = append(items, issueItem{issue: issue})
+	}
+	m.issueList.SetItems(items)
+	m.selectedIssue = issues[0]
+	m.currentScreen = issueDetailScreen
+
+	m, _ = m.handleComplete()
+	m, _ = m.handlePRInput(tea.KeyMsg{Type: tea.KeyEnter})
+	if m.currentScreen != issueListScreen || m.issueList.Index() != 2 {
+		t.Fatalf("after claiming #1 screen = %v with index %d, want the issue list at #3", m.currentScreen, m.issueList.Index())
+	}
+	if !strings.Contains(m.status, "Marked octo/docs#1 as completed") || !strings.Contains(m.status, "moved on to #3") {
+		t.Errorf("status = %q, want the claim and the advance", m.status)
+	}
+
+	m, _ = m.handleComplete()
+	m, _ = m.handlePRInput(tea.KeyMsg{Type: tea.KeyEnter})
+	if !strings.Contains(m.status, "no unclaimed issues left") {
+		t.Errorf("status = %q, want no unclaimed issues left", m.status)
+	}
+}
+
+func TestTotalPages(t *testing.T) {
+	tests := []struct {
+		name           string
+		count, perPage int
+		want           int
+	}{
+		{"empty list", 0, 10, 1},
+		{"single item", 1, 10, 1},
+		{"exactly one page", 10, 10, 1},
+		{"partial last page", 11, 10, 2},
+		{"zero page size", 3, 0, 3},
+		{"negative page size", 3, -5, 3},
+		{"empty list with zero page size", 0, 0, 1},
+	}
+
+	for _, tt := range tests {
+		t.Run(tt.name, func(t *testing.T) {
+			if got := totalPages(tt.count, tt.perPage); got != tt.want {
+				t.Errorf("totalPages(%d, %d) = %d, want %d", tt.count, tt.perPage, got, tt.want)
+			}
+		})
+	}
+}
+
+func TestPageSizeFallsBackToDefault(t *testing.T) {
+	defaults := config.DefaultConfig()
+
+	for _, maxRepos := range []int{0, -1} {
+		m := Model{config: &config.Config{MaxRepos: maxRepos}}
+		if got := m.pageSize(); got != defaults.MaxRepos {
+			t.Errorf("pageSize() with MaxRepos %d = %d, want default %d", maxRepos, got, defaults.MaxRepos)
+		}
+	}
+
+	m := Model{config: &config.Config{MaxRepos: 1}}
+	if got := m.pageSize(); got != 1 {
+		t.Errorf("pageSize() with MaxRepos 1 = %d, want 1", got)
+	}
+}
+
+func TestPageSizeKeysLeaveConfigAlone(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	defaults := config.DefaultConfig()
+	m := NewModel(config.DefaultConfig())
+	m.currentScreen = repoListScreen
+
+	m, _ = m.handlePageSize(pageSizeStep)
+	if got, want := m.pageSize(), defaults.MaxRepos+pageSizeStep; got != want {
+		t.Errorf("pageSize() after growing = %d, want %d", got, want)
+	}
+	if m.config.MaxRepos != defaults.MaxRepos {
+		t.Errorf("Config.MaxRepos after growing = %d, want %d so saving leaves it alone", m.config.MaxRepos, defaults.MaxRepos)
+	}
+}
+
+func TestLuckyIssueIndexIsReproducible(t *testing.T) {
+	newIssue := func(number, difficulty int, assignees ...string) list.Item {
+		issue := &gh.Issue{Number: gh.Int(number)}
+		for _, login := range assignees {
+			issue.Assignees = append(issue.Assignees, &gh.User{Login: gh.String(login)})
+		}
+		return issueItem{issue: &github.Issue{Issue: issue, DifficultyScore: difficulty}}
+	}
+	items := []list.Item{
+		sectionItem{title: "Easy", count: 2},
+		newIssue(1, 10),
+		newIssue(2, 20, "someone"),
+		newIssue(3, 45),
+		newIssue(4, 90),
+	}
+	notCompleted := func(*github.Issue) bool { return false }
+
+	first := luckyIssueIndex(items, 45, notCompleted, newRandom(42))
+	for range 10 {
+		if got := luckyIssueIndex(items, 45, notCompleted, newRandom(42)); got != first {
+			t.Fatalf("luckyIssueIndex with the same seed = %d, want %d", got, first)
+		}
+	}
+
+	rng := newRandom(7)
+	for range 200 {
+		switch idx := luckyIssueIndex(items, 45, notCompleted, rng); idx {
+		case 1, 3, 4:
+		default:
+			t.Fatalf("luckyIssueIndex = %d, want an unassigned issue", idx)
+		}
+	}
+
+	if got := luckyIssueIndex(items[:3], 45, func(issue *github.Issue) bool { return true }, rng); got != -1 {
+		t.Errorf("luckyIssueIndex with every issue completed = %d, want -1", got)
+	}
+}
+
+func TestRapidEnterOnWelcomeStartsOneSearch(t *testing.T) {
+	t.Setenv("HOME", t.TempDir()) // keep the local store out of the real home
+	m := NewModel(config.DefaultConfig())
+	enter := tea.KeyMsg{Type: tea.KeyEnter}
+
+	updated, cmd := m.Update(enter)
+	if cmd == nil {
+		t.Fatal("first Enter on the welcome screen issued no command, want the repository search")
+	}
+	m = updated.(Model)
+	if !m.loading {
+		t.Fatal("first Enter on the welcome screen left loading unset")
+	}
+
+	if _, cmd := m.Update(enter); cmd != nil {
+		t.Error("second Enter while loading issued a command, want the search to start once")
+	}
+	if _, cmd := m.handleEnter(); cmd != nil {
+		t.Error("handleEnter while loading issued a command, want the search to start once")
+	}
+}
+
+func TestQuitWhileLoading(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	m.loading = true
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("ctrl+c while loading issued no command, want quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Error("ctrl+c while loading didn't quit")
+	}
+}
+
+func TestAutoRefreshBadgesReposNewToTheSession(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	repo := func(name string) *github.Repository {
+		return &github.Repository{Repository: &gh.Repository{
+			Owner: &gh.User{Login: gh.String("octo")},
+			Name:  gh.String(name),
+		}}
+	}
+
+	updated, _ := m.Update(reposLoadedMsg{repos: []*github.Repository{repo("old")}, currentPage: 1})
+	updated, _ = updated.(Model).Update(reposLoadedMsg{
+		repos:       []*github.Repository{repo("old"), repo("fresh")},
+		currentPage: 1,
+		background:  true,
+		autoRefresh: true,
+	})
+	m = updated.(Model)
+
+	for _, item := range m.repoList.Items() {
+		repo := item.(repoItem)
+		if want := repo.repo.GetName() == "fresh"; repo.isNew != want {
+			t.Errorf("%s isNew = %v, want %v", repoKey(repo.repo), repo.isNew, want)
+		}
+	}
+}
+
+func TestDifficultyColorsKeepDefaultsForInvalidValues(t *testing.T) {
+	defer setDifficultyColors(nil)
+	setDifficultyColors(map[string]string{"Easy": "#00A0FF", "hard": "orange", "trivial": "#FFFFFF"})
+
+	if got := difficultyStyle(10).GetForeground(); got != lipgloss.Color("#00A0FF") {
+		t.Errorf("easy color = %v, want the #00A0FF override", got)
+	}
+	if got := difficultyStyle(70).GetForeground(); got != HardStyle.GetForeground() {
+		t.Errorf("hard color = %v, want the default for an invalid hex value", got)
+	}
+	if got := difficultyStyle(50).GetForeground(); got != MediumStyle.GetForeground() {
+		t.Errorf("medium color = %v, want the default", got)
+	}
+}
+
+func TestFilterIssuesBySkillSortsByDistanceToTarget(t *testing.T) {
+	issue := func(number, score int) *github.Issue {
+		return &github.Issue{Issue: &gh.Issue{Number: gh.Int(number)}, DifficultyScore: score}
+	}
+	issues := []*github.Issue{issue(1, 90), issue(2, github.UnknownDifficulty), issue(3, 20), issue(4, 70), issue(5, 20)}
+
+	numbers := func(issues []*github.Issue) []int {
+		var got []int
+		for _, issue := range issues {
+			got = append(got, issue.Issue.GetNumber())
+		}
+		return got
+	}
+	for level, want := range map[string][]int{
+		"beginner": {3, 5, 4, 1, 2},
+		"advanced": {4, 1, 3, 5, 2},
+		"unknown":  {3, 4, 5, 1, 2}, // intermediate, 45
+	} {
+		if got := numbers(FilterIssuesBySkill(issues, level)); fmt.Sprint(got) != fmt.Sprint(want) {
+			t.Errorf("%s: got %v, want %v", level, got, want)
+		}
+	}
+	if got := numbers(issues); fmt.Sprint(got) != "[1 2 3 4 5]" {
+		t.Errorf("FilterIssuesBySkill reordered its input to %v", got)
+	}
+}
+
+func TestTopicFilterNarrowsRepoListUntilBack(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	repo := func(name string, topics ...string) *github.Repository {
+		return &github.Repository{Repository: &gh.Repository{
+			Owner:  &gh.User{Login: gh.String("octo")},
+			Name:   gh.String(name),
+			Topics: append([]string{"hacktoberfest"}, topics...),
+		}}
+	}
+
+	updated, _ := m.Update(reposLoadedMsg{
+		repos:       []*github.Repository{repo("tool", "cli"), repo("server", "api"), repo("client", "api", "CLI")},
+		currentPage: 1,
+	})
+	m = updated.(Model)
+	if m.topicStats["cli"] != 2 || m.topicStats["api"] != 2 || m.topicStats["hacktoberfest"] != 0 {
+		t.Fatalf("topicStats = %v, want cli and api on two repositories each", m.topicStats)
+	}
+
+	m, _ = m.handleLabels()
+	if m.currentScreen != topicsScreen {
+		t.Fatalf("L on the repo list opened screen %v, want the topics screen", m.currentScreen)
+	}
+	m.topicList.Select(1) // "cli", after "api" on the tie
+	m, _ = m.handleEnter()
+	if m.currentScreen != repoListScreen || len(m.repoList.Items()) != 2 {
+		t.Fatalf("selecting cli shows %d repositories on screen %v, want 2 on the repo list", len(m.repoList.Items()), m.currentScreen)
+	}
+
+	m, _ = m.handleBack()
+	if m.currentScreen != repoListScreen || m.topicFilter != "" || len(m.repoList.Items()) != 3 {
+		t.Errorf("back shows %d repositories with topic filter %q, want all 3", len(m.repoList.Items()), m.topicFilter)
+	}
+}
+
+func TestContributionTypeCyclesThroughTheListedKinds(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	issue := func(number int, labels ...string) *github.Issue {
+		var ghLabels []*gh.Label
+		for _, label := range labels {
+			ghLabels = append(ghLabels, &gh.Label{Name: gh.String(label)})
+		}
+		return &github.Issue{Issue: &gh.Issue{
+			Number:        gh.Int(number),
+			Title:         gh.String(fmt.Sprintf("Issue %d", number)),
+			RepositoryURL: gh.String("https://api.github.com/repos/octo/docs"),
+			Labels:        ghLabels,
+		}}
+	}
+
+	updated, _ := m.Update(issuesLoadedMsg{
+		source: "octo/docs",
+		issues: []*github.Issue{issue(1, "Documentation"), issue(2, "bug"), issue(3, "good first issue"), issue(4, "docs", "bug")},
+	})
+	m = updated.(Model)
+	m.currentScreen = issueListScreen
+	if kind := m.issueList.Items()[0].(issueItem).kind; kind != "docs" {
+		t.Errorf("badge for a Documentation issue = %q, want docs", kind)
+	}
+
+	for _, want := range []struct {
+		filter string
+		items  int
+	}{{"code", 1}, {"docs", 2}, {"", 4}} {
+		m, _ = m.handleContributionType()
+		if m.typeFilter != want.filter || len(m.issueList.Items()) != want.items {
+			t.Fatalf("type filter %q shows %d issues, want %q with %d", m.typeFilter, len(m.issueList.Items()), want.filter, want.items)
+		}
+	}
+}
+
+func TestBookmarksExportAsTaskList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	saved := []*github.Issue{
+		{Issue: &gh.Issue{
+			Number:        gh.Int(7),
+			Title:         gh.String("Fix the docs"),
+			RepositoryURL: gh.String("https://api.github.com/repos/octo/docs"),
+			HTMLURL:       gh.String("https://github.com/octo/docs/issues/7"),
+		}, DifficultyScore: 20},
+		{Issue: &gh.Issue{
+			Number:        gh.Int(3),
+			Title:         gh.String("Add a flag"),
+			RepositoryURL: gh.String("https://api.github.com/repos/octo/cli"),
+			HTMLURL:       gh.String("https://github.com/octo/cli/issues/3"),
+		}, DifficultyScore: 70},
+	}
+	for _, issue := range saved {
+		if err := bookmarks.Add(issue); err != nil {
+			t.Fatalf("Add returned error: %v", err)
+		}
+	}
+
+	m.currentScreen = bookmarksScreen
+	m, _ = m.handleExport()
+	data, err := os.ReadFile(bookmarksTaskListPath())
+	if err != nil {
+		t.Fatalf("reading the export: %v (status %q)", err, m.status)
+	}
+	want := "### octo/cli\n- [ ] octo/cli#3 Add a flag (Hard) — https://github.com/octo/cli/issues/3\n\n" +
+		"### octo/docs\n- [ ] octo/docs#7 Fix the docs (Easy) — https://github.com/octo/docs/issues/7\n"
+	if string(data) != want {
+		t.Errorf("bookmarks task list =\n%s\nwant\n%s", data, want)
+	}
+}
+
+func TestRelaxFiltersStepsAndLeavesConfigAlone(t *testing.T) {
+	filters := relaxedFilters{minStars: 100, languages: []string{"Go", "Rust"}, pushedWithin: 120}
+	var steps []string
+	for {
+		var step string
+		var ok bool
+		if filters, step, ok = filters.relax(); !ok {
+			break
+		}
+		steps = append(steps, step)
+	}
+	want := []string{
+		"lowered MinStars from 100 to 50",
+		"lowered MinStars from 50 to 25",
+		"dropped MinStars 25",
+		"widened recency from 120 to 240 days",
+		"dropped the 240-day recency filter",
+		"dropped language 'Rust'",
+		"dropped language 'Go'",
+	}
+	if strings.Join(steps, "; ") != strings.Join(want, "; ") {
+		t.Fatalf("relax steps = %q, want %q", steps, want)
+	}
+
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	updated, _ := m.Update(filtersRelaxedMsg{
+		filters: relaxedFilters{minStars: 5, languages: []string{"Go"}},
+		steps:   []string{"lowered MinStars from 20 to 10", "lowered MinStars from 10 to 5"},
+		loaded:  reposLoadedMsg{currentPage: 1, resetToFirst: true},
+	})
+	m = updated.(Model)
+	if m.config.MinStars != 20 || len(m.config.PreferredLanguages) != 4 {
+		t.Errorf("config after relaxing = %d stars, %v, want the defaults untouched", m.config.MinStars, m.config.PreferredLanguages)
+	}
+	if opts := m.repoSearchOptions(); opts.MinStars != 5 || len(opts.Languages) != 1 {
+		t.Errorf("search options after relaxing = %d stars, %v, want 5 stars and Go", opts.MinStars, opts.Languages)
+	}
+}
+
+func TestHistoryRerunLeavesConfigAlone(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	m.historyList.SetItems([]list.Item{historyItem{summary: history.SearchSummary{Search: history.Entry{
+		Event:     history.EventSearch,
+		Languages: []string{"Rust"},
+		MinStars:  500,
+		OwnerType: "org",
+	}}}})
+
+	m, _ = m.handleHistorySelect()
+	if m.config.MinStars != 20 || len(m.config.PreferredLanguages) != 4 || m.config.OwnerType != "" {
+		t.Errorf("config after re-running a search = %d stars, %v, owner %q, want the defaults untouched",
+			m.config.MinStars, m.config.PreferredLanguages, m.config.OwnerType)
+	}
+	if opts := m.repoSearchOptions(); opts.MinStars != 500 || len(opts.Languages) != 1 || opts.OwnerType != "org" {
+		t.Errorf("search options after re-running a search = %d stars, %v, owner %q, want the recorded criteria",
+			opts.MinStars, opts.Languages, opts.OwnerType)
+	}
+}
+
+func TestPresetLanguagesLeaveConfigAlone(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	cfg := config.DefaultConfig()
+	cfg.FilterPresets = []config.FilterPreset{{Name: "rusty", Languages: []string{"Rust"}}}
+	m := NewModel(cfg)
+	m.currentScreen = repoListScreen
+
+	m, _ = m.handlePreset(tea.KeyMsg{Type: tea.KeyF1})
+	if len(m.config.PreferredLanguages) != 4 {
+		t.Errorf("configured languages after a preset = %v, want the defaults untouched", m.config.PreferredLanguages)
+	}
+	if opts := m.repoSearchOptions(); len(opts.Languages) != 1 || opts.Languages[0] != "Rust" {
+		t.Errorf("search languages after a preset = %v, want the preset's", opts.Languages)
+	}
+}
+
+func TestInvalidQueryFooterFollowsTheRefreshBinding(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	m.currentScreen = repoListScreen
+	m.error = &github.InvalidQueryError{Query: "topic:hacktoberfest stars:>=-1", Messages: []string{"bad stars"}}
+
+	view := m.repoListView()
+	if !strings.Contains(view, keyHint("Retry", m.keys.Refresh)) || strings.Contains(view, keyHint("Retry", m.keys.Reset)) {
+		t.Errorf("invalid query footer should offer retry on the refresh key, not reset:\n%s", view)
+	}
+}
+
+func TestQuitFromTheResetPrompt(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	m.confirmReset = true
+
+	_, cmd := m.Update(tea.KeyMsg{Type: tea.KeyCtrlC})
+	if cmd == nil {
+		t.Fatal("ctrl+c on the reset prompt returned no command, want tea.Quit")
+	}
+	if _, ok := cmd().(tea.QuitMsg); !ok {
+		t.Errorf("ctrl+c on the reset prompt did not quit")
+	}
+}
+
+// typeKeys sends each rune to the model as a key press and feeds back the
+// filter matches the lists compute in their commands
+func typeKeys(m Model, keys string) Model {
+	for _, r := range keys {
+		updated, cmd := m.Update(tea.KeyMsg{Type: tea.KeyRunes, Runes: []rune{r}})
+		m = updated.(Model)
+		for _, msg := range filterMatches(cmd) {
+			updated, _ = m.Update(msg)
+			m = updated.(Model)
+		}
+	}
+	return m
+}
+
+// filterMatches runs cmd, skipping timers such as the cursor blink, and
+// returns the list filter results it produced
+func filterMatches(cmd tea.Cmd) []tea.Msg {
+	if cmd == nil {
+		return nil
+	}
+	done := make(chan tea.Msg, 1)
+	go func() { done <- cmd() }()
+	var msg tea.Msg
+	select {
+	case msg = <-done:
+	case <-time.After(50 * time.Millisecond):
+		return nil
+	}
+
+	switch msg := msg.(type) {
+	case tea.BatchMsg:
+		var matches []tea.Msg
+		for _, c := range msg {
+			matches = append(matches, filterMatches(c)...)
+		}
+		return matches
+	case list.FilterMatchesMsg:
+		return []tea.Msg{msg}
+	}
+	return nil
+}
+
+func TestTypingFiltersTheLabelAndTopicBrowsers(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig()).resize(80, 40)
+
+	m.labelList.SetItems([]list.Item{labelItem{name: "docs", count: 2}, labelItem{name: "bug", count: 1}, labelItem{name: "good first issue", count: 3}})
+	m.currentScreen = labelsScreen
+	m = typeKeys(m, "/doc")
+	if got := len(m.labelList.VisibleItems()); got != 1 {
+		t.Errorf("labels browser shows %d of 3 labels after typing doc, want 1", got)
+	}
+
+	m.topicList.SetItems([]list.Item{topicItem{name: "docs", count: 2}, topicItem{name: "cli", count: 1}, topicItem{name: "api", count: 3}})
+	m.currentScreen = topicsScreen
+	m = typeKeys(m, "/doc")
+	if got := len(m.topicList.VisibleItems()); got != 1 {
+		t.Errorf("topics browser shows %d of 3 topics after typing doc, want 1", got)
+	}
+}
+
+func TestReadmeFailureKeepsTheRepoList(t *testing.T) {
+	t.Setenv("HOME", t.TempDir())
+	m := NewModel(config.DefaultConfig())
+	m.currentScreen = repoListScreen
+	repo := &github.Repository{Repository: &gh.Repository{
+		Owner: &gh.User{Login: gh.String("octo")},
+		Name:  gh.String("bare"),
+	}}
+
+	updated, _ := m.Update(reposLoadedMsg{repos: []*github.Repository{repo}, currentPage: 1})
+	updated, _ = updated.(Model).Update(readmeFailedMsg{repo: repo, err: github.ErrNoReadme})
+	m = updated.(Model)
+	if m.error != nil || m.status != "octo/bare has no README" {
+		t.Errorf("after a missing README error = %v, status = %q, want no error and a status", m.error, m.status)
+	}
+
+	m.error = fmt.Errorf("timed out")
+	updated, _ = m.Update(reposLoadedMsg{repos: []*github.Repository{repo}, currentPage: 1})
+	if err := updated.(Model).error; err != nil {
+		t.Errorf("error after a successful load = %v, want it cleared", err)
+	}
+}
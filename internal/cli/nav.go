@@ -0,0 +1,213 @@
+package cli
+
+import (
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	"github.com/charmbracelet/bubbles/viewport"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+)
+
+// maxForwardHistory bounds how many screens going back can remember
+const maxForwardHistory = 20
+
+// navSnapshot captures a screen together with the data it was showing, so a
+// screen left with back can be re-entered exactly as it was
+type navSnapshot struct {
+	screen screen
+
+	// Repository list
+	repos           []*github.Repository
+	repoList        list.Model
+	topicStats      map[string]int
+	topicFilter     string
+	unfilteredRepos []list.Item
+	topicList       list.Model
+	currentPage     int
+	totalRepos      int
+	candidateCnt    int
+	hasMorePages    bool
+	broadened       bool
+	incomplete      bool
+	cachedAt        time.Time
+
+	// Issue list and detail
+	selectedRepo     *github.Repository
+	selectedIssue    *github.Issue
+	linkedPRs        []github.LinkedPR
+	projects         []github.ProjectCard
+	activity         []github.TimelineEvent
+	commitActivity   []int
+	issues           []*github.Issue
+	issueList        list.Model
+	labelList        list.Model
+	labelFilter      string
+	typeFilter       string
+	preset           *config.FilterPreset
+	unfilteredIssues []list.Item
+	labelStats       map[string]int
+	excludedCount    int
+	takenCount       int
+	discussedCount   int
+	commentedCount   int
+	assignedCount    int
+	snoozedCount     int
+	seenCount        int
+	unknownCount     int
+	issuesSource     string
+	issuesTitle      string
+	issuesNote       string
+
+	// Viewports
+	readmeView    viewport.Model
+	historyList   list.Model
+	bookmarksList list.Model
+	completedView viewport.Model
+}
+
+// snapshot captures the current screen and its data
+func (m Model) snapshot() navSnapshot {
+	return navSnapshot{
+		screen:           m.currentScreen,
+		repos:            m.repos,
+		repoList:         m.repoList,
+		topicStats:       m.topicStats,
+		topicFilter:      m.topicFilter,
+		unfilteredRepos:  m.unfilteredRepos,
+		topicList:        m.topicList,
+		currentPage:      m.currentPage,
+		totalRepos:       m.totalRepos,
+		candidateCnt:     m.candidateCnt,
+		hasMorePages:     m.hasMorePages,
+		broadened:        m.broadened,
+		incomplete:       m.incomplete,
+		cachedAt:         m.cachedAt,
+		selectedRepo:     m.selectedRepo,
+		selectedIssue:    m.selectedIssue,
+		linkedPRs:        m.linkedPRs,
+		commitActivity:   m.commitActivity,
+		projects:         m.projects,
+		activity:         m.activity,
+		issues:           m.issues,
+		issueList:        m.issueList,
+		labelList:        m.labelList,
+		labelFilter:      m.labelFilter,
+		typeFilter:       m.typeFilter,
+		preset:           m.preset,
+		unfilteredIssues: m.unfilteredIssues,
+		labelStats:       m.labelStats,
+		excludedCount:    m.excludedCount,
+		takenCount:       m.takenCount,
+		discussedCount:   m.discussedCount,
+		commentedCount:   m.commentedCount,
+		assignedCount:    m.assignedCount,
+		snoozedCount:     m.snoozedCount,
+		seenCount:        m.seenCount,
+		unknownCount:     m.unknownCount,
+		issuesSource:     m.issuesSource,
+		issuesTitle:      m.issuesTitle,
+		issuesNote:       m.issuesNote,
+		readmeView:       m.readmeView,
+		historyList:      m.historyList,
+		bookmarksList:    m.bookmarksList,
+		completedView:    m.completedView,
+	}
+}
+
+// restore puts a snapshot back on screen, laid out for the current terminal
+// and list density
+func (m Model) restore(s navSnapshot) Model {
+	m.currentScreen = s.screen
+	m.repos = s.repos
+	m.repoList = s.repoList
+	m.topicStats = s.topicStats
+	m.topicFilter = s.topicFilter
+	m.unfilteredRepos = s.unfilteredRepos
+	m.topicList = s.topicList
+	m.currentPage = s.currentPage
+	m.totalRepos = s.totalRepos
+	m.candidateCnt = s.candidateCnt
+	m.hasMorePages = s.hasMorePages
+	m.broadened = s.broadened
+	m.incomplete = s.incomplete
+	m.cachedAt = s.cachedAt
+	m.selectedRepo = s.selectedRepo
+	m.selectedIssue = s.selectedIssue
+	m.linkedPRs = s.linkedPRs
+	m.commitActivity = s.commitActivity
+	m.projects = s.projects
+	m.activity = s.activity
+	m.issues = s.issues
+	m.issueList = s.issueList
+	m.labelList = s.labelList
+	m.labelFilter = s.labelFilter
+	m.typeFilter = s.typeFilter
+	m.preset = s.preset
+	m.unfilteredIssues = s.unfilteredIssues
+	m.labelStats = s.labelStats
+	m.excludedCount = s.excludedCount
+	m.takenCount = s.takenCount
+	m.discussedCount = s.discussedCount
+	m.commentedCount = s.commentedCount
+	m.assignedCount = s.assignedCount
+	m.snoozedCount = s.snoozedCount
+	m.seenCount = s.seenCount
+	m.unknownCount = s.unknownCount
+	m.issuesSource = s.issuesSource
+	m.issuesTitle = s.issuesTitle
+	m.issuesNote = s.issuesNote
+	m.readmeView = s.readmeView
+	m.historyList = s.historyList
+	m.bookmarksList = s.bookmarksList
+	m.completedView = s.completedView
+
+	// The list layout may have been toggled since the snapshot was taken
+	m.repoList.SetDelegate(repoDelegate(m.config.CompactList))
+	m.issueList.SetDelegate(issueDelegate(m.config.CompactList))
+
+	height := m.contentHeight()
+	m.repoList.SetSize(m.width, height)
+	m.issueList.SetSize(m.width, height)
+	m.labelList.SetSize(m.width, height)
+	m.topicList.SetSize(m.width, height)
+	m.readmeView.Width, m.readmeView.Height = m.width, height
+	m.historyList.SetSize(m.width, height)
+	m.bookmarksList.SetSize(m.width, height)
+	m.completedView.Width, m.completedView.Height = m.width, height
+	return m
+}
+
+// pushForward remembers the current screen before going back, dropping the
+// oldest entry once the history is full
+func (m Model) pushForward() Model {
+	m.forward = append(m.forward, m.snapshot())
+	if len(m.forward) > maxForwardHistory {
+		m.forward = m.forward[len(m.forward)-maxForwardHistory:]
+	}
+	return m
+}
+
+// enterScreen switches to a newly loaded screen. Like a browser, navigating
+// somewhere new discards the screens that going forward could return to.
+func (m Model) enterScreen(s screen) Model {
+	if s != m.currentScreen {
+		m.forward = nil
+	}
+	m.currentScreen = s
+	return m
+}
+
+// handleForward re-enters the screen most recently left with back
+func (m Model) handleForward() (Model, tea.Cmd) {
+	if len(m.forward) == 0 {
+		m.status = "Nothing to go forward to"
+		return m, nil
+	}
+
+	last := m.forward[len(m.forward)-1]
+	m.forward = m.forward[:len(m.forward)-1]
+	return m.restore(last), nil
+}
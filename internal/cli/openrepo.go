@@ -0,0 +1,87 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/textinput"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// newRepoInput creates the text input used to open a repository by name
+func newRepoInput() textinput.Model {
+	input := textinput.New()
+	input.Placeholder = "owner/name"
+	input.Prompt = "Repository: "
+	input.CharLimit = 100
+	return input
+}
+
+// WithStartRepo makes the explorer open straight on the issues of the given
+// "owner/name" repository instead of the welcome screen, skipping the search
+func (m Model) WithStartRepo(ref string) (Model, error) {
+	owner, name, err := github.ParseRepoReference(ref)
+	if err != nil {
+		return m, err
+	}
+
+	m.startRepo = github.RepositoryRef(owner, name)
+	return m, nil
+}
+
+// handleOpenRepo prompts for a repository to open from the welcome screen
+func (m Model) handleOpenRepo() (Model, tea.Cmd) {
+	if m.currentScreen != welcomeScreen {
+		return m, nil
+	}
+
+	m.promptRepo = true
+	m.repoInput = newRepoInput()
+	return m, m.repoInput.Focus()
+}
+
+// handleRepoInput feeds keys to the repository prompt: enter opens the
+// repository's issues if the name is valid, esc cancels
+func (m Model) handleRepoInput(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch msg.Type {
+	case tea.KeyEsc:
+		m.promptRepo = false
+		return m, nil
+
+	case tea.KeyEnter:
+		owner, name, err := github.ParseRepoReference(m.repoInput.Value())
+		if err != nil {
+			m.status = err.Error()
+			return m, nil
+		}
+		m.promptRepo = false
+		return m.openRepo(github.RepositoryRef(owner, name))
+	}
+
+	var cmd tea.Cmd
+	m.repoInput, cmd = m.repoInput.Update(msg)
+	return m, cmd
+}
+
+// openRepo loads the issues of a repository directly, without a search
+func (m Model) openRepo(repo *github.Repository) (Model, tea.Cmd) {
+	logger.Info(fmt.Sprintf("Opening %s directly, skipping the repository search", repoKey(repo)))
+
+	m.selectedRepo = repo
+	m.selectedIssue = nil
+	m.error = nil
+	m.loading = true
+	return m, m.loadIssues(repo)
+}
+
+// repoPromptView renders the repository prompt on the welcome screen
+func (m Model) repoPromptView() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		RenderStatus("Open a repository's issues directly"),
+		m.repoInput.View(),
+		MetaStyle.Render("Enter: Open • Esc: Cancel"),
+	)
+}
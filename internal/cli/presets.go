@@ -0,0 +1,113 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// presetKeys maps the preset keys to positions in Config.FilterPresets
+var presetKeys = map[string]int{"f1": 0, "f2": 1, "f3": 2, "f4": 3}
+
+// presetDifficulties are the difficulty names a preset may ask for
+var presetDifficulties = map[string]bool{"easy": true, "medium": true, "hard": true, "expert": true, "unknown": true}
+
+// handlePreset applies the filter preset bound to the pressed key. Preset
+// languages re-run the repository search, unless an issue list is open, in
+// which case they apply to the next search. The issue filters narrow the
+// open issue list and every issue list loaded until back clears them.
+func (m Model) handlePreset(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch m.currentScreen {
+	case welcomeScreen, repoListScreen, issueListScreen:
+	default:
+		return m, nil
+	}
+
+	idx := presetKeys[msg.String()]
+	if idx >= len(m.config.FilterPresets) {
+		m.status = fmt.Sprintf("No filter preset %d, add one to filter_presets in your config", idx+1)
+		return m, nil
+	}
+
+	preset := m.config.FilterPresets[idx]
+	if preset.Difficulty != "" && !presetDifficulties[strings.ToLower(preset.Difficulty)] {
+		logger.Warn(fmt.Sprintf("Ignoring unknown difficulty %q in filter preset %q", preset.Difficulty, preset.Name))
+		preset.Difficulty = ""
+	}
+	logger.Info(fmt.Sprintf("Applying filter preset %q: %+v", preset.Name, preset))
+	m.preset = &preset
+	m.status = fmt.Sprintf("Applied filter preset %q", preset.Name)
+
+	if criteria := m.activeCriteria(); len(preset.Languages) > 0 && !slices.Equal(preset.Languages, criteria.languages) {
+		criteria.languages = preset.Languages
+		m.criteria = &criteria
+		m.github.ClearRepoSearchCache()
+		if m.currentScreen != issueListScreen {
+			m.loading = true
+			m.relaxSteps = nil
+			m.relaxExhausted = false
+			m.relaxed = nil
+			m.pendingSearch = true
+			return m, m.loadRepositories()
+		}
+		m.status += ", its languages apply to the next search"
+	}
+
+	if m.currentScreen == issueListScreen {
+		m = m.applyPreset()
+	}
+	return m, nil
+}
+
+// applyPreset narrows the issue list to the issues matching the active
+// preset's difficulty, labels and assignment
+func (m Model) applyPreset() Model {
+	preset := m.preset
+	m.labelFilter = ""
+	m.typeFilter = ""
+	return m.narrowIssues(func(issue *github.Issue) bool {
+		if preset.Difficulty != "" && !strings.EqualFold(difficultyName(issue.DifficultyScore), preset.Difficulty) {
+			return false
+		}
+		if preset.Unassigned && len(issue.Issue.Assignees) > 0 {
+			return false
+		}
+		return len(preset.Labels) == 0 || hasAnyLabel(issue, preset.Labels)
+	})
+}
+
+// hasAnyLabel reports whether the issue carries any of labels, ignoring case
+func hasAnyLabel(issue *github.Issue, labels []string) bool {
+	for _, l := range issue.Issue.Labels {
+		for _, label := range labels {
+			if strings.EqualFold(l.GetName(), label) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// presetDescription summarizes what a preset filters issues by
+func presetDescription(preset *config.FilterPreset) string {
+	var parts []string
+	if preset.Difficulty != "" {
+		parts = append(parts, strings.ToLower(preset.Difficulty))
+	}
+	if preset.Unassigned {
+		parts = append(parts, "unassigned")
+	}
+	if len(preset.Labels) > 0 {
+		parts = append(parts, "labelled "+strings.Join(preset.Labels, " or "))
+	}
+	if len(parts) == 0 {
+		return "any issue"
+	}
+	return strings.Join(parts, ", ")
+}
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/logger"
+)
+
+// maxStarHalvings bounds how often relaxing halves the star threshold before
+// dropping it altogether, since every step costs a full search
+const maxStarHalvings = 2
+
+// maxPushedWithinDays is the widest recency window relaxing tries before
+// dropping the recency filter
+const maxPushedWithinDays = 365
+
+// relaxedFilters are the search filters relax-and-retry loosens. They are
+// kept apart from the config so relaxing never changes the saved settings.
+type relaxedFilters struct {
+	minStars     int
+	languages    []string
+	pushedWithin int // days, 0 for no recency filter
+	starHalvings int
+}
+
+// filtersRelaxedMsg carries the loosened filters and the search they produced
+type filtersRelaxedMsg struct {
+	filters   relaxedFilters
+	steps     []string
+	exhausted bool
+	loaded    reposLoadedMsg
+}
+
+// relax loosens the most restrictive search filter by one step. It halves
+// the star threshold up to maxStarHalvings times and then drops it, widens
+// the recency window, then drops the lowest priority language, and reports
+// false once there is nothing left to relax.
+func (f relaxedFilters) relax() (relaxedFilters, string, bool) {
+	switch {
+	case f.minStars > 1 && f.starHalvings < maxStarHalvings:
+		relaxed := f.minStars / 2
+		step := fmt.Sprintf("lowered MinStars from %d to %d", f.minStars, relaxed)
+		f.minStars = relaxed
+		f.starHalvings++
+		return f, step, true
+	case f.minStars > 0:
+		step := fmt.Sprintf("dropped MinStars %d", f.minStars)
+		f.minStars = 0
+		return f, step, true
+	case f.pushedWithin > 0 && f.pushedWithin*2 <= maxPushedWithinDays:
+		step := fmt.Sprintf("widened recency from %d to %d days", f.pushedWithin, f.pushedWithin*2)
+		f.pushedWithin *= 2
+		return f, step, true
+	case f.pushedWithin > 0:
+		step := fmt.Sprintf("dropped the %d-day recency filter", f.pushedWithin)
+		f.pushedWithin = 0
+		return f, step, true
+	case len(f.languages) > 0:
+		dropped := f.languages[len(f.languages)-1]
+		f.languages = f.languages[:len(f.languages)-1]
+		return f, fmt.Sprintf("dropped language '%s'", dropped), true
+	}
+	return f, "", false
+}
+
+// relaxAndSearch repeatedly relaxes the search filters and re-runs the search
+// until repositories are found or no filter is left to relax
+func (m Model) relaxAndSearch() tea.Cmd {
+	opts := m.repoSearchOptions()
+	filters := relaxedFilters{
+		minStars:     opts.MinStars,
+		languages:    append([]string(nil), opts.Languages...),
+		pushedWithin: opts.PushedWithinDays,
+	}
+	if m.relaxed != nil {
+		filters.starHalvings = m.relaxed.starHalvings
+	}
+
+	return func() tea.Msg {
+		var steps []string
+		apiCalls := 0
+
+		for {
+			var step string
+			var ok bool
+			filters, step, ok = filters.relax()
+			if !ok {
+				logger.Info("No filters left to relax, giving up")
+				return filtersRelaxedMsg{
+					filters:   filters,
+					steps:     steps,
+					exhausted: true,
+					loaded:    reposLoadedMsg{currentPage: 1, resetToFirst: true, apiCalls: apiCalls},
+				}
+			}
+			steps = append(steps, step)
+			logger.Info(fmt.Sprintf("Relaxed search filters: %s", step))
+
+			m.github.ClearRepoSearchCache()
+			opts.MinStars = filters.minStars
+			opts.Languages = filters.languages
+			opts.PushedWithinDays = filters.pushedWithin
+			result, err := m.github.SearchHacktoberfestReposWithPage(opts, m.pageSize(), 1)
+			if err != nil {
+				logger.ErrorWithErr("Relaxed repository search failed in CLI", err)
+				return errorMsg{err: err}
+			}
+
+			apiCalls += result.APICalls
+
+			if len(result.Repositories) > 0 {
+				return filtersRelaxedMsg{
+					filters: filters,
+					steps:   steps,
+					loaded: reposLoadedMsg{
+						repos:        result.Repositories,
+						totalRepoCnt: result.TotalAvailable,
+						candidateCnt: result.CandidateCount,
+						currentPage:  1,
+						hasMore:      m.pageSize() < result.CandidateCount,
+						resetToFirst: true,
+						broadened:    result.Broadened,
+						incomplete:   result.Incomplete,
+						apiCalls:     apiCalls,
+					},
+				}
+			}
+		}
+	}
+}
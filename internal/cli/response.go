@@ -0,0 +1,37 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"time"
+
+	"hacktober/internal/github"
+)
+
+// formatWait renders a wait compactly in its largest whole unit: "2d", "5h"
+// or "40m"
+func formatWait(wait time.Duration) string {
+	switch {
+	case wait >= 24*time.Hour:
+		return fmt.Sprintf("%dd", int(wait/(24*time.Hour)))
+	case wait >= time.Hour:
+		return fmt.Sprintf("%dh", int(wait/time.Hour))
+	case wait >= time.Minute:
+		return fmt.Sprintf("%dm", int(wait/time.Minute))
+	default:
+		return "<1m"
+	}
+}
+
+// sortByFirstResponse orders issues by how quickly someone first replied,
+// fastest first, keeping issues without a known response in their order at
+// the end
+func sortByFirstResponse(issues []*github.Issue) {
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := issues[i].FirstResponse, issues[j].FirstResponse
+		if a == nil || b == nil {
+			return a != nil && b == nil
+		}
+		return *a < *b
+	})
+}
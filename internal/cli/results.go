@@ -0,0 +1,89 @@
+package cli
+
+import (
+	"fmt"
+	"slices"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/logger"
+	"hacktober/internal/store"
+)
+
+// cacheRefreshFailedMsg reports that refreshing cached results failed, so the
+// cached results stay on screen
+type cacheRefreshFailedMsg struct {
+	err error
+}
+
+// saveLastResults persists the current page of repositories for the next launch
+func (m Model) saveLastResults() {
+	if !m.config.PersistLastResults || len(m.repos) == 0 {
+		return
+	}
+
+	criteria := m.activeCriteria()
+	results := &store.LastResults{
+		SavedAt:        time.Now(),
+		Languages:      criteria.languages,
+		MinStars:       criteria.minStars,
+		MaxStars:       criteria.maxStars,
+		Page:           m.currentPage,
+		Repos:          m.repos,
+		TotalAvailable: m.totalRepos,
+		CandidateCount: m.candidateCnt,
+		HasMore:        m.hasMorePages,
+	}
+	if err := results.Save(); err != nil {
+		logger.ErrorWithErr("Failed to save last search results", err)
+	}
+}
+
+// loadLastResults shows the saved results from the last session straight
+// away and refreshes them in the background. Results saved for different
+// search settings are ignored.
+func (m Model) loadLastResults() tea.Cmd {
+	if !m.config.PersistLastResults {
+		return nil
+	}
+
+	results, err := store.LoadLastResults()
+	if err != nil {
+		logger.ErrorWithErr("Failed to load last search results", err)
+		return nil
+	}
+	if results == nil || len(results.Repos) == 0 ||
+		results.MinStars != m.config.MinStars || results.MaxStars != m.config.MaxStars || !slices.Equal(results.Languages, m.config.PreferredLanguages) {
+		return nil
+	}
+
+	logger.Info(fmt.Sprintf("Showing %d cached repositories from %v while refreshing", len(results.Repos), results.SavedAt))
+
+	cached := func() tea.Msg {
+		return reposLoadedMsg{
+			repos:        results.Repos,
+			totalRepoCnt: results.TotalAvailable,
+			candidateCnt: results.CandidateCount,
+			currentPage:  results.Page,
+			hasMore:      results.HasMore,
+			resetToFirst: true,
+			cachedAt:     results.SavedAt,
+		}
+	}
+
+	refresh := m.loadRepositoriesPage(max(results.Page, 1))
+	background := func() tea.Msg {
+		switch msg := refresh().(type) {
+		case errorMsg:
+			return cacheRefreshFailedMsg{err: msg.err}
+		case reposLoadedMsg:
+			msg.background = true
+			return msg
+		default:
+			return msg
+		}
+	}
+
+	return tea.Sequence(cached, background)
+}
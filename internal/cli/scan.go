@@ -0,0 +1,162 @@
+package cli
+
+import (
+	"fmt"
+
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// scanSource identifies issue lists produced by a cross-repo scan
+const scanSource = "scan"
+
+// scanProgressMsg reports progress of a running cross-repo issue scan
+type scanProgressMsg struct {
+	progress github.ScanProgress
+}
+
+// scanDoneMsg carries the results of a finished cross-repo issue scan
+type scanDoneMsg struct {
+	result *github.ScanResult
+}
+
+// scanState tracks a running cross-repo issue scan
+type scanState struct {
+	progress github.ScanProgress
+	found    []*github.Issue // issues streamed in so far
+	control  *github.ScanControl
+	updates  chan github.ScanProgress
+	done     chan *github.ScanResult
+}
+
+// startScan launches a good first issue scan over the given repositories in
+// the background and returns the command that waits for its first update
+func (m Model) startScan(repos []*github.Repository) (*scanState, tea.Cmd) {
+	state := &scanState{
+		progress: github.ScanProgress{Total: len(repos)},
+		control:  m.github.NewScanControl(),
+		updates:  make(chan github.ScanProgress, len(repos)),
+		done:     make(chan *github.ScanResult, 1),
+	}
+
+	logger.Info(fmt.Sprintf("Starting good first issue scan of %d repos via CLI command", len(repos)))
+
+	go func() {
+		state.done <- m.github.ScanGoodFirstIssues(repos, m.config.ScanConcurrency, state.control, state.updates)
+	}()
+
+	return state, waitForScan(state)
+}
+
+// waitForScan waits for the next progress update, or the final result once
+// the progress channel is closed
+func waitForScan(state *scanState) tea.Cmd {
+	return func() tea.Msg {
+		if progress, ok := <-state.updates; ok {
+			return scanProgressMsg{progress: progress}
+		}
+		return scanDoneMsg{result: <-state.done}
+	}
+}
+
+func (m Model) handleScan() (Model, tea.Cmd) {
+	if m.currentScreen != repoListScreen || len(m.repos) == 0 {
+		return m, nil
+	}
+
+	// A scan keeps running while browsing, so return to it instead of
+	// starting another
+	if m.scan != nil {
+		m = m.enterScreen(scanScreen)
+		return m, nil
+	}
+
+	state, cmd := m.startScan(m.repos)
+	m.scan = state
+	m = m.enterScreen(scanScreen)
+	return m, cmd
+}
+
+// handleScanPause pauses or resumes the running scan
+func (m Model) handleScanPause() (Model, tea.Cmd) {
+	if m.scan == nil || (m.currentScreen != scanScreen && !m.viewingScanResults()) {
+		return m, nil
+	}
+
+	if m.scan.control.Paused() {
+		m.scan.control.Resume()
+		logger.Info("Resumed good first issue scan")
+	} else {
+		m.scan.control.Pause()
+		logger.Info("Paused good first issue scan")
+	}
+	if m.viewingScanResults() {
+		m.issuesNote = m.scanStatus()
+	}
+	return m, nil
+}
+
+// viewingScanResults reports whether the issue list shows scan results
+func (m Model) viewingScanResults() bool {
+	return m.currentScreen == issueListScreen && m.issuesSource == scanSource
+}
+
+// scanResultsMsg lists the issues a scan has found so far, or all of them
+// once it has finished
+func scanResultsMsg(issues []*github.Issue, note string, background bool) issuesLoadedMsg {
+	return issuesLoadedMsg{
+		source:     scanSource,
+		title:      "Good First Issues Across Repositories",
+		note:       note,
+		issues:     issues,
+		background: background,
+	}
+}
+
+// browseScan shows the issues found so far while the scan continues
+func (m Model) browseScan() (Model, tea.Cmd) {
+	m.selectedRepo = nil
+	m.selectedIssue = nil
+	m.excludedCount = 0
+	m.takenCount = 0
+	m.discussedCount = 0
+	m.commentedCount = 0
+	m.assignedCount = 0
+	updated, cmd := m.Update(scanResultsMsg(m.scan.found, m.scanStatus(), false))
+	return updated.(Model), cmd
+}
+
+// scanStatus describes the running scan, e.g. "scanning... (paused)"
+func (m Model) scanStatus() string {
+	if m.scan == nil {
+		return ""
+	}
+	state := "scanning..."
+	if m.scan.control.Paused() {
+		state += " (paused)"
+	}
+	return fmt.Sprintf("%s %d/%d repos", state, m.scan.progress.Scanned, m.scan.progress.Total)
+}
+
+func (m Model) scanView() string {
+	progress := github.ScanProgress{}
+	if m.scan != nil {
+		progress = m.scan.progress
+	}
+
+	return lipgloss.JoinVertical(lipgloss.Left,
+		RenderHeader("Scanning for Good First Issues"),
+		"",
+		RenderProgressBar(progress.Scanned, progress.Total, 40),
+		RenderStatus(fmt.Sprintf("%s, found %d issues", m.scanStatus(), progress.Found)),
+		"",
+		m.renderFooter(
+			keyHint("Pause/resume", m.keys.Pause),
+			keyHint("Browse issues found so far", m.keys.Enter),
+			keyHint("Back (keeps scanning)", m.keys.Back),
+		),
+	)
+}
@@ -0,0 +1,112 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// isSeen reports whether the issue was marked seen and hasn't been updated
+// since, so it should stay hidden
+func (m Model) isSeen(issue *github.Issue) bool {
+	seenAt, ok := m.store.Seen[issueKey(issue)]
+	return ok && !issue.Issue.GetUpdatedAt().After(seenAt)
+}
+
+// hideSeen drops issues marked seen when Config.HideSeenIssues is on,
+// returning the rest and how many were hidden
+func (m Model) hideSeen(issues []*github.Issue) ([]*github.Issue, int) {
+	if !m.config.HideSeenIssues {
+		return issues, 0
+	}
+	shown := make([]*github.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if !m.isSeen(issue) {
+			shown = append(shown, issue)
+		}
+	}
+	return shown, len(issues) - len(shown)
+}
+
+// handleMarkAllSeen marks every issue visible in the issue list, after any
+// label, preset or typed filter, as seen so it doesn't come back
+func (m Model) handleMarkAllSeen() (Model, tea.Cmd) {
+	if m.currentScreen != issueListScreen {
+		return m, nil
+	}
+
+	now := time.Now()
+	marked := make(map[string]bool)
+	for _, item := range m.issueList.VisibleItems() {
+		if current, ok := item.(issueItem); ok {
+			key := issueKey(current.issue)
+			m.store.Seen[key] = now
+			marked[key] = true
+		}
+	}
+	if len(marked) == 0 {
+		m.status = "No visible issues to mark as seen"
+		return m, nil
+	}
+	m.saveStore("Failed to save seen issues")
+	logger.Info(fmt.Sprintf("Marked %d issues as seen", len(marked)))
+	m.status = fmt.Sprintf("Marked %d issues as seen", len(marked))
+
+	if !m.config.HideSeenIssues {
+		return m, nil
+	}
+
+	// Take the issues out of the list right away
+	shown := make([]*github.Issue, 0, len(m.issues))
+	for _, issue := range m.issues {
+		if !marked[issueKey(issue)] {
+			shown = append(shown, issue)
+		}
+	}
+	m.issues = shown
+	m.labelStats = countLabels(m.issues)
+	m.seenCount += len(marked)
+	if m.unfilteredIssues != nil {
+		m.unfilteredIssues = withoutIssues(m.unfilteredIssues, marked)
+	}
+	return m, m.issueList.SetItems(withoutIssues(m.issueList.Items(), marked))
+}
+
+// withoutIssues drops the issue items whose keys are in keys, along with
+// section headers left without issues, and recounts the remaining sections
+func withoutIssues(items []list.Item, keys map[string]bool) []list.Item {
+	kept := make([]list.Item, 0, len(items))
+	header := -1
+	for _, item := range items {
+		switch current := item.(type) {
+		case sectionItem:
+			if header >= 0 && kept[header].(sectionItem).count == 0 {
+				kept = kept[:header]
+			}
+			current.count = 0
+			header = len(kept)
+			kept = append(kept, current)
+		case issueItem:
+			if keys[issueKey(current.issue)] {
+				continue
+			}
+			if header >= 0 {
+				section := kept[header].(sectionItem)
+				section.count++
+				kept[header] = section
+			}
+			kept = append(kept, current)
+		default:
+			kept = append(kept, item)
+		}
+	}
+	if header >= 0 && kept[header].(sectionItem).count == 0 {
+		kept = kept[:header]
+	}
+	return kept
+}
@@ -0,0 +1,114 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/charmbracelet/bubbles/key"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/config"
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// applyClientSettings configures the client's API timeouts and retries,
+// difficulty scoring and issue body limit from the configuration
+func applyClientSettings(client *github.Client, cfg *config.Config) {
+	client.SetTimeouts(time.Duration(cfg.RequestTimeout)*time.Second, time.Duration(cfg.SearchTimeout)*time.Second)
+	client.SetDifficultyLabels(cfg.DifficultyLabelMap)
+	client.SetUnknownDifficulty(unlabeledDifficulty(cfg) != "medium")
+	client.SetMaxIssueBodyBytes(cfg.MaxIssueBodyBytes)
+	client.SetTotalCountRetries(cfg.TotalCountRetries)
+	client.SetRateLimitRetries(cfg.RateLimitRetries, time.Duration(cfg.RateLimitMaxWait)*time.Second)
+}
+
+// unlabeledDifficultyModes are the accepted Config.UnlabeledDifficulty values
+var unlabeledDifficultyModes = map[string]bool{"medium": true, "unknown": true, "hide": true}
+
+// unlabeledDifficulty returns how issues without labels or comments are
+// treated, "medium" when unset or unknown
+func unlabeledDifficulty(cfg *config.Config) string {
+	mode := strings.ToLower(cfg.UnlabeledDifficulty)
+	if !unlabeledDifficultyModes[mode] {
+		if mode != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown unlabeled_difficulty %q, scoring them as medium", cfg.UnlabeledDifficulty))
+		}
+		return "medium"
+	}
+	return mode
+}
+
+// resetPrompt asks the user to confirm resetting the configuration
+const resetPrompt = "Reset all settings except your GitHub token to their defaults? (y/n)"
+
+// handleResetConfirm resolves a pending reset: "y" restores the default
+// configuration and saves it, quit exits and any other key cancels
+func (m Model) handleResetConfirm(msg tea.KeyMsg) (Model, tea.Cmd) {
+	m.confirmReset = false
+	if key.Matches(msg, m.keys.Quit) {
+		return m.quit()
+	}
+	if msg.String() != "y" {
+		m.status = "Reset cancelled"
+		return m, nil
+	}
+
+	m.config.ResetToDefaults()
+	applyClientSettings(m.github, m.config)
+	setIcons(m.config.UseEmoji)
+	setDifficultyColors(m.config.DifficultyColors)
+	m.detailFields = validDetailFields(m.config.IssueDetailFields)
+	m.excludeLabels = true
+	m.relaxSteps = nil
+	m.relaxExhausted = false
+	m.relaxed = nil
+	m.criteria = nil
+	m.perPage = 0
+	m.github.ClearRepoSearchCache()
+
+	if err := m.config.Save(); err != nil {
+		logger.ErrorWithErr("Failed to save reset configuration", err)
+		m.error = err
+		return m, nil
+	}
+
+	logger.Info("Configuration reset to defaults")
+	m.error = nil
+	m.status = "Settings reset to defaults and saved"
+	return m, nil
+}
+
+// pageSizeStep and maxPageSize bound how far the page size keys move the
+// page size
+const (
+	pageSizeStep = 10
+	maxPageSize  = 100
+)
+
+// handlePageSize grows or shrinks the repository page by pageSizeStep and
+// searches again. The change lasts for the session and isn't saved.
+func (m Model) handlePageSize(delta int) (Model, tea.Cmd) {
+	if m.currentScreen != repoListScreen || m.loading {
+		return m, nil
+	}
+
+	size := min(max(m.pageSize()+delta, 1), maxPageSize)
+	if size == m.pageSize() {
+		m.status = fmt.Sprintf("Already showing %d repositories per page", size)
+		return m, nil
+	}
+
+	m.perPage = size
+	m.loading = true
+	load := m.loadRepositoriesPage(1)
+	return m, func() tea.Msg {
+		msg := load()
+		if loaded, ok := msg.(reposLoadedMsg); ok {
+			loaded.status = fmt.Sprintf("Showing up to %d repositories per page", size)
+			return loaded
+		}
+		return msg
+	}
+}
@@ -0,0 +1,95 @@
+package cli
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/atotto/clipboard"
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// FormatIssueMarkdown formats an issue as a short markdown card for chats and
+// issue comments that render markdown
+func FormatIssueMarkdown(issue *github.Issue, repo, difficulty string) string {
+	lines := []string{
+		fmt.Sprintf("**%s** (#%d)", issue.Issue.GetTitle(), issue.Issue.GetNumber()),
+		fmt.Sprintf("**Repository:** %s", repo),
+		fmt.Sprintf("**Difficulty:** %s", difficulty),
+	}
+	if labels := issueLabelNames(issue); len(labels) > 0 {
+		lines = append(lines, fmt.Sprintf("**Labels:** %s", strings.Join(labels, ", ")))
+	}
+	lines = append(lines, issue.Issue.GetHTMLURL())
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// FormatIssuePlain formats an issue as a plain-text card without markdown
+// syntax, for destinations such as Slack that don't render it
+func FormatIssuePlain(issue *github.Issue, repo, difficulty string) string {
+	lines := []string{
+		fmt.Sprintf("%s (#%d)", issue.Issue.GetTitle(), issue.Issue.GetNumber()),
+		fmt.Sprintf("Repository: %s", repo),
+		fmt.Sprintf("Difficulty: %s", difficulty),
+	}
+	if labels := issueLabelNames(issue); len(labels) > 0 {
+		lines = append(lines, fmt.Sprintf("Labels: %s", strings.Join(labels, ", ")))
+	}
+	lines = append(lines, issue.Issue.GetHTMLURL())
+	return strings.Join(lines, "\n") + "\n"
+}
+
+// issueLabelNames returns the names of an issue's labels in their order
+func issueLabelNames(issue *github.Issue) []string {
+	names := make([]string, 0, len(issue.Issue.Labels))
+	for _, label := range issue.Issue.Labels {
+		names = append(names, label.GetName())
+	}
+	return names
+}
+
+// shareFormats are the accepted Config.DefaultShareFormat values
+var shareFormats = map[string]bool{"markdown": true, "plain": true}
+
+// shareFormat returns the format the share key copies, "markdown" when unset
+// or unknown
+func (m Model) shareFormat() string {
+	format := strings.ToLower(m.config.DefaultShareFormat)
+	if !shareFormats[format] {
+		if format != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown default_share_format %q, using markdown", m.config.DefaultShareFormat))
+		}
+		return "markdown"
+	}
+	return format
+}
+
+// handleShare copies the open or selected issue as a card to the clipboard,
+// in Config.DefaultShareFormat or, with alternate, in the other format
+func (m Model) handleShare(alternate bool) (Model, tea.Cmd) {
+	issue := m.completionTarget()
+	if issue == nil {
+		return m, nil
+	}
+
+	format := m.shareFormat()
+	if alternate {
+		format = map[string]string{"markdown": "plain", "plain": "markdown"}[format]
+	}
+
+	repo, _, _ := strings.Cut(issueKey(issue), "#")
+	card := FormatIssueMarkdown(issue, repo, difficultyName(issue.DifficultyScore))
+	if format == "plain" {
+		card = FormatIssuePlain(issue, repo, difficultyName(issue.DifficultyScore))
+	}
+
+	if err := clipboard.WriteAll(card); err != nil {
+		logger.ErrorWithErr("Failed to copy issue card", err)
+		m.status = fmt.Sprintf("Copy failed: %v", err)
+		return m, nil
+	}
+	m.status = fmt.Sprintf("Copied %s as %s", issueKey(issue), format)
+	return m, nil
+}
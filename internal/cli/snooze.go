@@ -0,0 +1,92 @@
+package cli
+
+import (
+	"fmt"
+	"time"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// snoozedUntil returns when a snoozed issue resurfaces, or the zero time if
+// the issue isn't snoozed
+func (m Model) snoozedUntil(issue *github.Issue) time.Time {
+	return m.store.Snoozed[issueKey(issue)]
+}
+
+// isSnoozed reports whether the issue is snoozed and should stay hidden
+func (m Model) isSnoozed(issue *github.Issue) bool {
+	return time.Now().Before(m.snoozedUntil(issue))
+}
+
+// isBackFromSnooze reports whether the issue's snooze has run out and it
+// hasn't been looked at since
+func (m Model) isBackFromSnooze(issue *github.Issue) bool {
+	until := m.snoozedUntil(issue)
+	return !until.IsZero() && !time.Now().Before(until)
+}
+
+// hideSnoozed drops snoozed issues, returning the rest and how many were hidden
+func (m Model) hideSnoozed(issues []*github.Issue) ([]*github.Issue, int) {
+	shown := make([]*github.Issue, 0, len(issues))
+	for _, issue := range issues {
+		if !m.isSnoozed(issue) {
+			shown = append(shown, issue)
+		}
+	}
+	return shown, len(issues) - len(shown)
+}
+
+// handleSnooze hides the target issue for Config.SnoozeDays
+func (m Model) handleSnooze() (Model, tea.Cmd) {
+	issue := m.completionTarget()
+	if issue == nil {
+		return m, nil
+	}
+
+	until := time.Now().AddDate(0, 0, max(1, m.config.SnoozeDays))
+	key := issueKey(issue)
+	m.store.Snoozed[key] = until
+	m.saveStore("Failed to save snoozed issues")
+	logger.Info(fmt.Sprintf("Snoozed %s until %s", key, until.Format(time.RFC3339)))
+
+	// Take the issue out of the list right away
+	for i, item := range m.issueList.Items() {
+		if current, ok := item.(issueItem); ok && issueKey(current.issue) == key {
+			m.issueList.RemoveItem(i)
+			break
+		}
+	}
+	if idx := indexOfIssueKey(m.issues, key); idx >= 0 {
+		m.issues = append(m.issues[:idx:idx], m.issues[idx+1:]...)
+	}
+	m.snoozedCount++
+
+	if m.currentScreen == issueDetailScreen {
+		m.currentScreen = issueListScreen
+	}
+	m.status = fmt.Sprintf("Snoozed %s until %s", key, until.Format("Jan 2"))
+	return m, nil
+}
+
+// acknowledgeSnooze forgets an expired snooze once the issue has been opened
+func (m Model) acknowledgeSnooze(issue *github.Issue) Model {
+	if !m.isBackFromSnooze(issue) {
+		return m
+	}
+	delete(m.store.Snoozed, issueKey(issue))
+	m.saveStore("Failed to save snoozed issues")
+	return m.refreshIssueItem(issue)
+}
+
+// indexOfIssueKey returns the index of the issue with the given issueKey
+func indexOfIssueKey(issues []*github.Issue, key string) int {
+	for i, issue := range issues {
+		if issueKey(issue) == key {
+			return i
+		}
+	}
+	return -1
+}
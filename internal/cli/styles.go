@@ -2,8 +2,13 @@ package cli
 
 import (
 	"fmt"
+	"regexp"
+	"strings"
 
 	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
 )
 
 // Theme colors
@@ -73,15 +78,18 @@ var (
 			Foreground(Accent).
 			Bold(true)
 
+	MutedLabelStyle = lipgloss.NewStyle().
+			Foreground(Muted)
+
 	// Content styles
 	ContentStyle = lipgloss.NewStyle().
 			Padding(0, 2).
 			MarginBottom(1)
 
+	// DescriptionStyle wraps to the terminal width, see descriptionWidth
 	DescriptionStyle = lipgloss.NewStyle().
 				Foreground(Muted).
-				Padding(0, 2).
-				Width(80)
+				Padding(0, 2)
 
 	// Footer styles
 	FooterStyle = lipgloss.NewStyle().
@@ -108,6 +116,10 @@ var (
 			Foreground(Warning).
 			Bold(true)
 
+	UnknownStyle = lipgloss.NewStyle().
+			Foreground(Muted).
+			Italic(true)
+
 	// Pagination styles
 	PaginationStyle = lipgloss.NewStyle().
 			Foreground(Info).
@@ -130,7 +142,7 @@ var (
 
 // Helper functions for consistent styling
 func RenderHeader(title string) string {
-	return HeaderStyle.Render("🎃 " + title)
+	return HeaderStyle.Render(icons.Header + " " + title)
 }
 
 func RenderSubHeader(title string) string {
@@ -158,26 +170,152 @@ func RenderSuccess(text string) string {
 }
 
 func RenderDifficulty(score int) string {
+	return difficultyStyle(score).Render("[" + difficultyName(score) + "]")
+}
+
+// hexColor matches the #rgb and #rrggbb colors accepted in
+// Config.DifficultyColors
+var hexColor = regexp.MustCompile(`^#([0-9a-fA-F]{3}|[0-9a-fA-F]{6})$`)
+
+// difficultyColors are the user's colors by lowercase difficulty band, see
+// setDifficultyColors
+var difficultyColors map[string]lipgloss.Color
+
+// setDifficultyColors validates Config.DifficultyColors, keeping the default
+// color of any band with an unknown name or a value that isn't a hex color
+func setDifficultyColors(overrides map[string]string) {
+	difficultyColors = make(map[string]lipgloss.Color, len(overrides))
+	for band, color := range overrides {
+		band = strings.ToLower(band)
+		switch {
+		case band != "easy" && band != "medium" && band != "hard" && band != "expert" && band != "unknown":
+			logger.Warn(fmt.Sprintf("Ignoring color for unknown difficulty %q", band))
+		case !hexColor.MatchString(color):
+			logger.Warn(fmt.Sprintf("Ignoring difficulty color %q for %s, expected a hex color like #06D6A0", color, band))
+		default:
+			difficultyColors[band] = lipgloss.Color(color)
+		}
+	}
+}
+
+// difficultyStyle returns the style of a difficulty score's band, in the
+// user's color for it if one is configured
+func difficultyStyle(score int) lipgloss.Style {
+	var style lipgloss.Style
 	switch {
+	case score == github.UnknownDifficulty:
+		style = UnknownStyle
 	case score <= 30:
-		return EasyStyle.Render("[Easy]")
+		style = EasyStyle
 	case score <= 60:
-		return MediumStyle.Render("[Medium]")
+		style = MediumStyle
 	case score <= 80:
-		return HardStyle.Render("[Hard]")
+		style = HardStyle
 	default:
-		return ExpertStyle.Render("[Expert]")
+		style = ExpertStyle
+	}
+
+	if color, ok := difficultyColors[strings.ToLower(difficultyName(score))]; ok {
+		style = style.Foreground(color)
 	}
+	return style
 }
 
 func RenderStars(count int) string {
-	return StarStyle.Render("⭐ ") + NumberStyle.Render(fmt.Sprintf("%d", count))
+	return StarStyle.Render(icons.Star+" ") + NumberStyle.Render(fmt.Sprintf("%d", count))
 }
 
 func RenderLanguage(lang string) string {
 	return LanguageStyle.Render("• " + lang)
 }
 
+// RenderLabels joins issue labels, drawing those in highlight (matched
+// case-insensitively) in the accent style and the rest muted. Without a
+// highlight set every label keeps the same plain weight.
+func RenderLabels(labels, highlight []string) string {
+	if len(highlight) == 0 {
+		return strings.Join(labels, ", ")
+	}
+
+	rendered := make([]string, len(labels))
+	for i, label := range labels {
+		style := MutedLabelStyle
+		for _, h := range highlight {
+			if strings.EqualFold(label, h) {
+				style = LabelStyle
+				break
+			}
+		}
+		rendered[i] = style.Render(label)
+	}
+	return strings.Join(rendered, MutedLabelStyle.Render(", "))
+}
+
 func RenderRelevanceScore(score int) string {
-	return LabelStyle.Render("[Score: ") + NumberStyle.Render(fmt.Sprintf("%d", score)) + LabelStyle.Render("]")
+	return LabelStyle.Render("[Score: ") + NumberStyle.Render(FormatScore(score)) + LabelStyle.Render("]")
+}
+
+// Plain-text score formatting shared by the list, compact, detail, tuner and
+// accessible views, so scores look the same everywhere
+
+// FormatScore formats a bare score number
+func FormatScore(score int) string {
+	return fmt.Sprintf("%d", score)
+}
+
+// FormatRelevanceScore formats a repository relevance score, e.g. "[Score: 120]"
+func FormatRelevanceScore(score int) string {
+	return "[Score: " + FormatScore(score) + "]"
+}
+
+// FormatDifficultyScore formats an issue difficulty score, e.g. "(40/100)"
+func FormatDifficultyScore(score int) string {
+	return "(" + FormatScore(score) + "/100)"
+}
+
+// FormatReadiness renders a readiness score as filled and empty dots, e.g. "●●●○○"
+func FormatReadiness(score, maxScore int) string {
+	return strings.Repeat("●", score) + strings.Repeat("○", max(0, maxScore-score))
+}
+
+// sparkBlocks are the bar heights of a sparkline, lowest first
+var sparkBlocks = []rune("▁▂▃▄▅▆▇█")
+
+// RenderSparkline draws counts as a row of bars scaled to the largest count,
+// so a dormant repository shows a flat line
+func RenderSparkline(counts []int) string {
+	peak := 0
+	for _, count := range counts {
+		peak = max(peak, count)
+	}
+
+	line := make([]rune, len(counts))
+	for i, count := range counts {
+		level := 0
+		if peak > 0 {
+			level = count * (len(sparkBlocks) - 1) / peak
+		}
+		line[i] = sparkBlocks[level]
+	}
+	return string(line)
+}
+
+// sumInts adds up counts
+func sumInts(counts []int) int {
+	total := 0
+	for _, count := range counts {
+		total += count
+	}
+	return total
+}
+
+// RenderProgressBar renders a horizontal progress bar of the given width
+func RenderProgressBar(done, total, width int) string {
+	filled := 0
+	if total > 0 {
+		filled = min(width, done*width/total)
+	}
+	bar := SuccessStyle.UnsetPadding().Render(strings.Repeat("█", filled)) +
+		MetaStyle.Render(strings.Repeat("░", width-filled))
+	return PaginationStyle.Render(bar)
 }
@@ -0,0 +1,132 @@
+package cli
+
+import (
+	"fmt"
+	"sort"
+	"strings"
+
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+)
+
+// topicItem is one topic with the number of listed repositories carrying it
+type topicItem struct {
+	name  string
+	count int
+}
+
+func (i topicItem) FilterValue() string { return i.name }
+func (i topicItem) Title() string       { return fmt.Sprintf("%s (%d)", i.name, i.count) }
+func (i topicItem) Description() string { return "" }
+
+// countTopics counts how many of the repositories carry each topic. The
+// hacktoberfest topic every search result shares is left out.
+func countTopics(repos []*github.Repository) map[string]int {
+	counts := make(map[string]int)
+	for _, repo := range repos {
+		for _, topic := range repo.Topics {
+			topic = strings.ToLower(topic)
+			if topic != "hacktoberfest" {
+				counts[topic]++
+			}
+		}
+	}
+	return counts
+}
+
+// sortTopicStats orders topic counts most used first, ties by name
+func sortTopicStats(stats map[string]int) []topicItem {
+	topics := make([]topicItem, 0, len(stats))
+	for name, count := range stats {
+		topics = append(topics, topicItem{name: name, count: count})
+	}
+	sort.Slice(topics, func(i, j int) bool {
+		if topics[i].count != topics[j].count {
+			return topics[i].count > topics[j].count
+		}
+		return topics[i].name < topics[j].name
+	})
+	return topics
+}
+
+// newTopicList creates the list used by the topics screen
+func newTopicList() list.Model {
+	topicList := list.New([]list.Item{}, compactDelegate{}, 0, 0)
+	topicList.Title = "Topics"
+	topicList.SetShowStatusBar(true)
+	topicList.SetFilteringEnabled(true)
+	topicList.SetShowHelp(true)
+	return topicList
+}
+
+// handleTopics opens the browser of every topic on the listed repositories,
+// most used first
+func (m Model) handleTopics() (Model, tea.Cmd) {
+	if len(m.topicStats) == 0 {
+		m.status = "None of the listed repositories have topics"
+		return m, nil
+	}
+
+	topics := sortTopicStats(m.topicStats)
+	items := make([]list.Item, len(topics))
+	for i, topic := range topics {
+		items[i] = topic
+	}
+
+	m.topicList.ResetFilter()
+	m.topicList.SetItems(items)
+	m.topicList.Select(0)
+	m.topicList.Title = fmt.Sprintf("Topics on %d repositories", len(m.repos))
+	m = m.enterScreen(topicsScreen)
+	return m, nil
+}
+
+// handleTopicSelect narrows the repo list to the repositories carrying the
+// selected topic
+func (m Model) handleTopicSelect() (Model, tea.Cmd) {
+	item, ok := m.topicList.SelectedItem().(topicItem)
+	if !ok {
+		return m, nil
+	}
+
+	if m.unfilteredRepos == nil {
+		m.unfilteredRepos = m.repoList.Items()
+	}
+	var items []list.Item
+	for _, listItem := range m.unfilteredRepos {
+		if repo, ok := listItem.(repoItem); ok && repo.repo.HasTopic(item.name) {
+			items = append(items, listItem)
+		}
+	}
+
+	m.topicFilter = item.name
+	m.repoList.ResetFilter()
+	m.repoList.SetItems(items)
+	m.repoList.Select(0)
+	return m.enterScreen(repoListScreen), nil
+}
+
+// clearTopicFilter restores the full repo list
+func (m Model) clearTopicFilter() Model {
+	if m.unfilteredRepos != nil {
+		m.repoList.SetItems(m.unfilteredRepos)
+		m.repoList.Select(0)
+	}
+	m.topicFilter = ""
+	m.unfilteredRepos = nil
+	return m
+}
+
+func (m Model) topicsScreenView() string {
+	return lipgloss.JoinVertical(lipgloss.Left,
+		m.topicList.View(),
+		m.renderFooter(
+			keyHint("Filter", m.topicList.KeyMap.Filter),
+			keyHint("Show repositories with this topic", m.keys.Enter),
+			keyHint("Back", m.keys.Back),
+		),
+	)
+}
@@ -0,0 +1,160 @@
+package cli
+
+import (
+	"fmt"
+
+	"github.com/charmbracelet/bubbles/key"
+	"github.com/charmbracelet/bubbles/list"
+	tea "github.com/charmbracelet/bubbletea"
+	"github.com/charmbracelet/lipgloss"
+
+	"hacktober/internal/github"
+	"hacktober/internal/logger"
+)
+
+// tunerPreviewRepos is how many of the re-ranked repositories the tuner shows
+const tunerPreviewRepos = 10
+
+// tunerWeight is one adjustable relevance weight on the tuner screen
+type tunerWeight struct {
+	name  string
+	value func(*github.RelevanceWeights) *int
+	step  int
+	min   int
+}
+
+// tunerWeights lists the adjustable weights in display order
+var tunerWeights = []tunerWeight{
+	{"Star score cap", func(w *github.RelevanceWeights) *int { return &w.Stars.Cap }, 10, 10},
+	{"Stars per point", func(w *github.RelevanceWeights) *int { return &w.Stars.Divisor }, 1, 1},
+	{"Recent activity bonus", func(w *github.RelevanceWeights) *int { return &w.RecentBonus }, 5, 0},
+	{"Language bonus", func(w *github.RelevanceWeights) *int { return &w.LanguageBonus }, 5, 0},
+	{"Dependency bonus", func(w *github.RelevanceWeights) *int { return &w.DependencyBonus }, 5, 0},
+}
+
+// tunerState tracks the weights being tuned
+type tunerState struct {
+	selected int
+	weights  github.RelevanceWeights
+	original github.RelevanceWeights // restored when tuning is cancelled
+}
+
+// handleTuner opens the relevance weight tuner for the loaded repositories
+func (m Model) handleTuner() (Model, tea.Cmd) {
+	if m.currentScreen != repoListScreen || len(m.repos) == 0 {
+		return m, nil
+	}
+
+	weights := m.relevanceWeights()
+	m.tuner = tunerState{weights: weights, original: weights}
+	m = m.enterScreen(tunerScreen)
+	return m, nil
+}
+
+// handleTunerKey moves between weights with up/down, adjusts the selected one
+// with left/right, saves with enter and cancels with q/esc
+func (m Model) handleTunerKey(msg tea.KeyMsg) (Model, tea.Cmd) {
+	switch {
+	case key.Matches(msg, m.keys.Up):
+		m.tuner.selected = max(0, m.tuner.selected-1)
+
+	case key.Matches(msg, m.keys.Down):
+		m.tuner.selected = min(len(tunerWeights)-1, m.tuner.selected+1)
+
+	case key.Matches(msg, m.keys.Left), key.Matches(msg, m.keys.Right):
+		weight := tunerWeights[m.tuner.selected]
+		value := weight.value(&m.tuner.weights)
+		if key.Matches(msg, m.keys.Left) {
+			*value = max(weight.min, *value-weight.step)
+		} else {
+			*value += weight.step
+		}
+		m = m.rerankRepos(m.tuner.weights)
+
+	case key.Matches(msg, m.keys.Enter):
+		return m.saveTunedWeights()
+
+	case key.Matches(msg, m.keys.Back):
+		m = m.rerankRepos(m.tuner.original)
+		m.currentScreen = repoListScreen
+		m.status = "Weight changes discarded"
+	}
+	return m, nil
+}
+
+// rerankRepos rescores the loaded repositories with weights and re-sorts the
+// repository list, keeping the selected repository selected
+func (m Model) rerankRepos(weights github.RelevanceWeights) Model {
+	for _, repo := range m.repos {
+		repo.Rescore(m.activeCriteria().languages, weights)
+	}
+	github.RankRepositories(m.repos)
+
+	selected := ""
+	if item, ok := m.repoList.SelectedItem().(repoItem); ok {
+		selected = repoKey(item.repo)
+	}
+
+	items := make([]list.Item, len(m.repos))
+	for i, repo := range m.repos {
+		items[i] = repoItem{repo: repo, showScores: m.config.ShowScores}
+	}
+	m.repoList.SetItems(items)
+	if idx := indexOfRepo(m.repos, selected); idx >= 0 {
+		m.repoList.Select(idx)
+	}
+	return m
+}
+
+// saveTunedWeights writes the tuned weights to the config file
+func (m Model) saveTunedWeights() (Model, tea.Cmd) {
+	weights := m.tuner.weights
+	m.config.StarScoreCap = weights.Stars.Cap
+	m.config.StarScoreDivisor = weights.Stars.Divisor
+	m.config.RecentActivityBonus = weights.RecentBonus
+	m.config.LanguageBonus = weights.LanguageBonus
+	m.config.DependencyBonus = weights.DependencyBonus
+	m.currentScreen = repoListScreen
+
+	if err := m.config.Save(); err != nil {
+		logger.ErrorWithErr("Failed to save tuned relevance weights", err)
+		m.error = err
+		return m, nil
+	}
+
+	logger.Info(fmt.Sprintf("Saved relevance weights: %+v", weights))
+	m.status = "Relevance weights saved, new searches rank with them"
+	return m, nil
+}
+
+func (m Model) tunerView() string {
+	lines := []string{
+		RenderHeader("Tune Relevance Weights"),
+		"",
+	}
+
+	for i, weight := range tunerWeights {
+		text := fmt.Sprintf("%-22s %d", weight.name, *weight.value(&m.tuner.weights))
+		if i == m.tuner.selected {
+			lines = append(lines, RenderSelectedItem(text))
+		} else {
+			lines = append(lines, RenderNormalItem(text))
+		}
+	}
+
+	lines = append(lines, "", RenderSubHeader(fmt.Sprintf("Ranking of the %d repos on this page", len(m.repos))))
+	for i, repo := range m.repos[:min(tunerPreviewRepos, len(m.repos))] {
+		lines = append(lines, fmt.Sprintf("  %2d. %s %s", i+1, repoKey(repo), MetaStyle.Render(FormatRelevanceScore(repo.RelevanceScore))))
+	}
+	if len(m.repos) > tunerPreviewRepos {
+		lines = append(lines, MetaStyle.Render(fmt.Sprintf("  ... and %d more", len(m.repos)-tunerPreviewRepos)))
+	}
+
+	lines = append(lines, "", m.renderFooter(
+		keyHint("Select weight", m.keys.Up, m.keys.Down),
+		keyHint("Adjust", m.keys.Left, m.keys.Right),
+		keyHint("Save", m.keys.Enter),
+		keyHint("Cancel", m.keys.Back),
+	))
+	return lipgloss.JoinVertical(lipgloss.Left, lines...)
+}
@@ -0,0 +1,97 @@
+package cli
+
+import (
+	"bufio"
+	"fmt"
+	"os"
+	"path/filepath"
+	"strings"
+
+	tea "github.com/charmbracelet/bubbletea"
+
+	"hacktober/internal/logger"
+)
+
+// watchlistSource identifies issue lists loaded from the watchlist file
+const watchlistSource = "watchlist"
+
+// watchlistPath returns the configured watchlist file, defaulting to
+// ~/.hacktober/watchlist.txt
+func (m Model) watchlistPath() string {
+	if m.config.WatchlistFile != "" {
+		return m.config.WatchlistFile
+	}
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "watchlist.txt")
+}
+
+// readWatchlist reads "owner/repo#number" references, one per line. Blank
+// lines and lines starting with # are ignored.
+func readWatchlist(path string) ([]string, error) {
+	file, err := os.Open(path)
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var refs []string
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		line := strings.TrimSpace(scanner.Text())
+		if line == "" || strings.HasPrefix(line, "#") {
+			continue
+		}
+		refs = append(refs, line)
+	}
+
+	return refs, scanner.Err()
+}
+
+func (m Model) loadWatchlist() tea.Cmd {
+	path := m.watchlistPath()
+
+	return func() tea.Msg {
+		logger.Info(fmt.Sprintf("Loading watchlist from %s via CLI command", path))
+
+		refs, err := readWatchlist(path)
+		if err != nil {
+			logger.ErrorWithErr("Watchlist loading failed in CLI", err)
+			return errorMsg{err: fmt.Errorf("failed to read watchlist: %w", err)}
+		}
+
+		issues, err := m.github.GetIssuesByReference(refs)
+		if err != nil {
+			logger.ErrorWithErr("Watchlist issue loading failed in CLI", err)
+			return errorMsg{err: err}
+		}
+
+		note := ""
+		if skipped := len(refs) - len(issues); skipped > 0 {
+			note = fmt.Sprintf("%d of %d watchlist entries could not be loaded, check logs for details", skipped, len(refs))
+		}
+
+		return issuesLoadedMsg{
+			source: watchlistSource,
+			title:  "Watchlist",
+			note:   note,
+			issues: issues,
+		}
+	}
+}
+
+func (m Model) handleWatchlist() (Model, tea.Cmd) {
+	if m.currentScreen != welcomeScreen {
+		return m, nil
+	}
+
+	m.selectedRepo = nil
+	m.selectedIssue = nil
+	m.excludedCount = 0
+	m.takenCount = 0
+	m.discussedCount = 0
+	m.commentedCount = 0
+	m.assignedCount = 0
+	m.error = nil
+	m.loading = true
+	return m, m.loadWatchlist()
+}
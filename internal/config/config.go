@@ -2,31 +2,184 @@ package config
 
 import (
 	"encoding/json"
+	"fmt"
 	"os"
 	"path/filepath"
 )
 
 // Config holds application configuration
 type Config struct {
-	GitHubToken        string   `json:"github_token"`
-	PreferredLanguages []string `json:"preferred_languages"`
-	SkillLevel         string   `json:"skill_level"` // beginner, intermediate, advanced
-	MaxRepos           int      `json:"max_repos"`
-	MaxIssuesPerRepo   int      `json:"max_issues_per_repo"`
+	GitHubToken             string         `json:"github_token"`
+	PreferredLanguages      []string       `json:"preferred_languages"`
+	SkillLevel              string         `json:"skill_level"` // beginner, intermediate, advanced
+	MaxRepos                int            `json:"max_repos"`
+	MaxIssuesPerRepo        int            `json:"max_issues_per_repo"`
+	MinStars                int            `json:"min_stars"`
+	MaxStars                int            `json:"max_stars"`          // upper bound on stars to target smaller repos, 0 disables
+	PushedWithinDays        int            `json:"pushed_within_days"` // only repos pushed to in the last this many days, 0 disables
+	ExcludeIssueLabels      []string       `json:"exclude_issue_labels"`
+	AccessibleMode          bool           `json:"accessible_mode"` // plain text output for screen readers
+	PinnedRepos             []string       `json:"pinned_repos"`    // owner/name repos always listed first
+	FetchPinnedRepos        bool           `json:"fetch_pinned_repos"`
+	RetryOnEmptyEnter       bool           `json:"retry_on_empty_enter"`       // Enter on an empty list retries instead of doing nothing
+	NewIssuesFirst          bool           `json:"new_issues_first"`           // sort issues new or updated since the last visit to the top
+	PreferMaintainerIssues  bool           `json:"prefer_maintainer_issues"`   // sort issues filed by maintainers first
+	SortBySkillLevel        bool           `json:"sort_by_skill_level"`        // sort issues closest to skill_level's difficulty first
+	ShowAuthorAssociation   bool           `json:"show_author_association"`    // show whether a maintainer or the community filed an issue
+	ShowFirstResponse       bool           `json:"show_first_response"`        // time to first response on the issue detail, one extra API call per issue
+	SortByFirstResponse     bool           `json:"sort_by_first_response"`     // sort issues answered fastest first, one extra API call per commented issue
+	HideIssuesWithOpenPRs   bool           `json:"hide_issues_with_open_prs"`  // costs one extra API call per issue
+	HideAssignedIssues      bool           `json:"hide_assigned_issues"`       // hide issues someone is assigned to
+	ShowScores              bool           `json:"show_scores"`                // show numeric relevance and difficulty scores
+	CheckReadiness          bool           `json:"check_readiness"`            // costs three extra API calls per listed repo
+	ShowActivity            bool           `json:"show_activity"`              // commit sparkline on the README screen, one extra API call per repo
+	ScanConcurrency         int            `json:"scan_concurrency"`           // parallel requests for the cross-repo issue scan
+	HeaderFooterReserve     int            `json:"header_footer_reserve"`      // terminal lines kept free around lists
+	WatchlistFile           string         `json:"watchlist_file"`             // owner/repo#number per line, defaults to ~/.hacktober/watchlist.txt
+	RequestTimeout          int            `json:"request_timeout"`            // seconds a single API request may take, 0 disables
+	SearchTimeout           int            `json:"search_timeout"`             // seconds a whole repository search may take, 0 disables
+	ResultCacheTTL          int            `json:"result_cache_ttl"`           // seconds search pages and issue lists are reused, 0 disables
+	TotalCountRetries       int            `json:"total_count_retries"`        // retries of the query counting all hacktoberfest repos
+	RateLimitRetries        int            `json:"rate_limit_retries"`         // times a rate limited search waits for the limit to reset and retries
+	RateLimitMaxWait        int            `json:"rate_limit_max_wait"`        // seconds a rate limited search may wait in total
+	StarScoreCap            int            `json:"star_score_cap"`             // most relevance points stars can contribute
+	StarScoreDivisor        int            `json:"star_score_divisor"`         // stars needed per relevance point
+	RecentActivityBonus     int            `json:"recent_activity_bonus"`      // relevance points for repos updated in the last month
+	PreferOrgs              bool           `json:"prefer_orgs"`                // relevance bonus for organization-owned repos
+	IncludeArchived         bool           `json:"include_archived"`           // also list archived (read-only) repos
+	OwnerType               string         `json:"owner_type"`                 // "org" or "user" to list only those owners, empty for both
+	DiscoveryMode           string         `json:"discovery_mode"`             // "topic", "label" for repos with hacktoberfest-labelled issues, or "both"
+	LanguageBonus           int            `json:"language_bonus"`             // relevance points for the first preferred language, 10 fewer per later one
+	DependencyRepos         []string       `json:"dependency_repos"`           // owner/name repos your projects depend on
+	DependencyFile          string         `json:"dependency_file"`            // go.mod, or a file of owner/name lines, adding to dependency_repos
+	DependencyBonus         int            `json:"dependency_bonus"`           // relevance points for repos you depend on
+	FamiliarRepoBehavior    string         `json:"familiar_repo_behavior"`     // repos you starred or contributed to: "off", "boost" or "flag"
+	FamiliarBonus           int            `json:"familiar_bonus"`             // relevance points for familiar repos when boosting
+	GroupIssuesByDifficulty bool           `json:"group_issues_by_difficulty"` // section the issue list into Easy/Medium/Hard/Expert
+	MinOpenIssues           int            `json:"min_open_issues"`            // hide repos with fewer open issues (GitHub counts PRs too), 0 disables
+	BroadenSearch           bool           `json:"broaden_search"`             // widen searches returning under a quarter page of results
+	FallbackLanguages       []string       `json:"fallback_languages"`         // languages a broadened search adds, empty means any language
+	IssueFetchSort          string         `json:"issue_fetch_sort"`           // server-side issue order: created, updated or comments
+	IssueFetchDirection     string         `json:"issue_fetch_direction"`      // asc or desc
+	IssueLabels             []string       `json:"issue_labels"`               // only fetch issues with these labels, empty for every open issue
+	IssueLabelMatchMode     string         `json:"issue_label_match_mode"`     // "all" needs every issue label, "any" one query per label
+	UseEmoji                bool           `json:"use_emoji"`                  // false swaps emoji for ASCII on terminals without emoji fonts
+	DifficultyColors        BandColors     `json:"difficulty_colors"`          // override the difficulty badge colors
+	ShowFooter              bool           `json:"show_footer"`                // key hints at the bottom of each screen
+	CompactList             bool           `json:"compact_list"`               // one line per repo and issue, toggled with v
+	PersistLastResults      bool           `json:"persist_last_results"`       // show the last session's results at startup while refreshing
+	AutoRefreshMinutes      int            `json:"auto_refresh_minutes"`       // refresh the repo list when idle this often, 0 disables
+	IssueDetailFields       []string       `json:"issue_detail_fields"`        // fields shown on the issue detail screen, in order
+	DifficultyLabelMap      map[string]int `json:"difficulty_label_map"`       // label name or /regex/ to a fixed difficulty score
+	ContributionTypeLabels  TypeLabels     `json:"contribution_type_labels"`   // label name to the contribution it asks for, cycled with K
+	UnlabeledDifficulty     string         `json:"unlabeled_difficulty"`       // issues without labels or comments: "medium", "unknown" or "hide"
+	MaxCommentsBeforeSkip   int            `json:"max_comments_before_skip"`   // hide issues with more comments than this, 0 disables
+	MaxIssueBodyBytes       int            `json:"max_issue_body_bytes"`       // issue bodies are cut to this size when fetched, 0 keeps them whole
+	HighlightLabels         []string       `json:"highlight_labels"`           // labels drawn in a bold accent, others muted
+	LabelStatsSort          string         `json:"label_stats_sort"`           // label summary order: "count", "alpha" or "rare"
+	LabelStatsLimit         int            `json:"label_stats_limit"`          // labels shown in the issue list summary
+	SnoozeDays              int            `json:"snooze_days"`                // how long z hides an issue
+	AutoAdvanceAfterClaim   bool           `json:"auto_advance_after_claim"`   // move to the next unclaimed issue after marking one completed
+	HideSeenIssues          bool           `json:"hide_seen_issues"`           // hide issues marked seen with the seen-all key (a) until they change
+	HideMyCommentedIssues   bool           `json:"hide_my_commented_issues"`   // hide issues you commented on, one extra search per issue list
+	TaskListGroupBy         string         `json:"task_list_group_by"`         // group exported task lists by "repo" or "difficulty"
+	DefaultShareFormat      string         `json:"default_share_format"`       // issue card copied by the share key, "markdown" or "plain"
+	FilterPresets           []FilterPreset `json:"filter_presets"`             // applied with F1-F4, in order
+	Seed                    int64          `json:"seed"`                       // seeds the random issue pick, 0 draws a new seed each run
+
+	path string // file loaded with LoadFrom, which Save writes back to
+}
+
+// PathEnv names the environment variable pointing Load at a config file
+// other than ~/.hacktober-config.json
+const PathEnv = "HACKTOBER_CONFIG"
+
+// BandColors maps lowercase difficulty bands (easy, medium, hard, expert,
+// unknown) to hex colors such as "#06D6A0"
+type BandColors map[string]string
+
+// TypeLabels maps issue label names (case-insensitive) to the kind of
+// contribution they ask for, such as "code", "docs" or "design"
+type TypeLabels map[string]string
+
+// FilterPreset is a named bundle of filters applied with a single key
+type FilterPreset struct {
+	Name       string   `json:"name"`
+	Languages  []string `json:"languages"`  // re-runs the repository search with these, empty keeps the current ones
+	Difficulty string   `json:"difficulty"` // easy, medium, hard or expert, empty for any
+	Labels     []string `json:"labels"`     // issues carrying any of these (case-insensitive), empty for any
+	Unassigned bool     `json:"unassigned"` // only issues nobody is assigned to
 }
 
 // DefaultConfig returns a configuration with sensible defaults
 func DefaultConfig() *Config {
 	return &Config{
-		PreferredLanguages: []string{"Go", "JavaScript", "Python", "TypeScript"},
-		SkillLevel:         "intermediate",
-		MaxRepos:           50,
-		MaxIssuesPerRepo:   20,
+		PreferredLanguages:    []string{"Go", "JavaScript", "Python", "TypeScript"},
+		SkillLevel:            "intermediate",
+		MaxRepos:              50,
+		MaxIssuesPerRepo:      20,
+		MinStars:              20,
+		ExcludeIssueLabels:    []string{"wontfix", "duplicate", "invalid"},
+		RetryOnEmptyEnter:     true,
+		ShowScores:            true,
+		ScanConcurrency:       5,
+		HeaderFooterReserve:   10,
+		RequestTimeout:        10,
+		SearchTimeout:         60,
+		TotalCountRetries:     2,
+		RateLimitRetries:      3,
+		RateLimitMaxWait:      60,
+		StarScoreCap:          100,
+		StarScoreDivisor:      10,
+		RecentActivityBonus:   20,
+		LanguageBonus:         50,
+		DependencyBonus:       40,
+		FamiliarRepoBehavior:  "off",
+		FamiliarBonus:         30,
+		IssueFetchSort:        "updated",
+		IssueFetchDirection:   "desc",
+		IssueLabelMatchMode:   "all",
+		UnlabeledDifficulty:   "medium",
+		TaskListGroupBy:       "repo",
+		DefaultShareFormat:    "markdown",
+		UseEmoji:              true,
+		DiscoveryMode:         "topic",
+		ShowFooter:            true,
+		ShowAuthorAssociation: true,
+		SnoozeDays:            7,
+		HideSeenIssues:        true,
+		LabelStatsSort:        "count",
+		LabelStatsLimit:       10,
+		MaxIssueBodyBytes:     16384,
+		ContributionTypeLabels: TypeLabels{
+			"documentation": "docs",
+			"docs":          "docs",
+			"design":        "design",
+			"ui":            "design",
+			"ux":            "design",
+			"bug":           "code",
+			"enhancement":   "code",
+			"feature":       "code",
+		},
 	}
 }
 
-// Load configuration from environment and config file
+// ResetToDefaults restores every option to its DefaultConfig value while
+// keeping the GitHub token
+func (c *Config) ResetToDefaults() {
+	token, path := c.GitHubToken, c.path
+	*c = *DefaultConfig()
+	c.GitHubToken, c.path = token, path
+}
+
+// Load configuration from environment and config file. The file named by
+// HACKTOBER_CONFIG is loaded with LoadFrom when set; otherwise
+// ~/.hacktober-config.json is optional and defaults are used without it.
 func Load() (*Config, error) {
+	if path := os.Getenv(PathEnv); path != "" {
+		return LoadFrom(path)
+	}
+
 	cfg := DefaultConfig()
 
 	// Try to load from config file
@@ -34,22 +187,51 @@ func Load() (*Config, error) {
 		// Config file is optional, continue with defaults
 	}
 
-	// Override with environment variables
+	applyEnv(cfg)
+	return cfg, nil
+}
+
+// LoadFrom loads configuration from an explicitly chosen file, which unlike
+// the default one must exist and parse. Save writes back to it.
+func LoadFrom(path string) (*Config, error) {
+	data, err := os.ReadFile(path)
+	if err != nil {
+		return nil, fmt.Errorf("failed to read config %s: %w", path, err)
+	}
+
+	cfg := DefaultConfig()
+	if err := json.Unmarshal(data, cfg); err != nil {
+		return nil, fmt.Errorf("failed to parse config %s: %w", path, err)
+	}
+	cfg.path = path
+
+	applyEnv(cfg)
+	return cfg, nil
+}
+
+// applyEnv overrides the configuration with environment variables
+func applyEnv(cfg *Config) {
 	if token := os.Getenv("GITHUB_TOKEN"); token != "" {
 		cfg.GitHubToken = token
 	}
+}
 
-	return cfg, nil
+// defaultPath returns ~/.hacktober-config.json
+func defaultPath() (string, error) {
+	homeDir, err := os.UserHomeDir()
+	if err != nil {
+		return "", err
+	}
+	return filepath.Join(homeDir, ".hacktober-config.json"), nil
 }
 
 // loadFromFile attempts to load configuration from ~/.hacktober-config.json
 func loadFromFile(cfg *Config) error {
-	homeDir, err := os.UserHomeDir()
+	configPath, err := defaultPath()
 	if err != nil {
 		return err
 	}
 
-	configPath := filepath.Join(homeDir, ".hacktober-config.json")
 	data, err := os.ReadFile(configPath)
 	if err != nil {
 		return err
@@ -58,18 +240,52 @@ func loadFromFile(cfg *Config) error {
 	return json.Unmarshal(data, cfg)
 }
 
-// Save configuration to file
+// Save configuration to the file it was loaded from with LoadFrom, or to
+// ~/.hacktober-config.json
 func (c *Config) Save() error {
-	homeDir, err := os.UserHomeDir()
-	if err != nil {
-		return err
+	configPath := c.path
+	if configPath == "" {
+		var err error
+		if configPath, err = defaultPath(); err != nil {
+			return err
+		}
 	}
 
-	configPath := filepath.Join(homeDir, ".hacktober-config.json")
 	data, err := json.MarshalIndent(c, "", "  ")
 	if err != nil {
 		return err
 	}
 
-	return os.WriteFile(configPath, data, 0644)
+	return writeFileAtomic(configPath, data, 0600)
+}
+
+// writeFileAtomic writes data to a temp file next to path and renames it into
+// place, so an interrupted write never leaves a truncated config behind
+func writeFileAtomic(path string, data []byte, perm os.FileMode) error {
+	dir := filepath.Dir(path)
+	tmp, err := os.CreateTemp(dir, "."+filepath.Base(path)+".tmp-*")
+	if err != nil {
+		return fmt.Errorf("failed to save config: %s is not writable: %w", dir, err)
+	}
+	tmpPath := tmp.Name()
+	defer os.Remove(tmpPath) // no-op once the rename succeeded
+
+	if _, err := tmp.Write(data); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if err := tmp.Sync(); err != nil {
+		tmp.Close()
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if err := tmp.Close(); err != nil {
+		return fmt.Errorf("failed to write config: %w", err)
+	}
+	if err := os.Chmod(tmpPath, perm); err != nil {
+		return fmt.Errorf("failed to set config permissions: %w", err)
+	}
+	if err := os.Rename(tmpPath, path); err != nil {
+		return fmt.Errorf("failed to replace config: %w", err)
+	}
+	return nil
 }
@@ -0,0 +1,170 @@
+package config
+
+import (
+	"os"
+	"path/filepath"
+	"reflect"
+	"testing"
+)
+
+// setupHome points HOME at a temp directory and clears GITHUB_TOKEN so tests
+// never pick up the developer's real configuration
+func setupHome(t *testing.T) string {
+	t.Helper()
+
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+	t.Setenv("GITHUB_TOKEN", "")
+	t.Setenv(PathEnv, "")
+
+	return home
+}
+
+func writeConfigFile(t *testing.T, home, contents string) {
+	t.Helper()
+
+	path := filepath.Join(home, ".hacktober-config.json")
+	if err := os.WriteFile(path, []byte(contents), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+}
+
+func TestLoadDefaultsWithoutFile(t *testing.T) {
+	setupHome(t)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(cfg, DefaultConfig()) {
+		t.Errorf("expected defaults, got %+v", cfg)
+	}
+}
+
+func TestLoadFileOverridesDefaults(t *testing.T) {
+	home := setupHome(t)
+	writeConfigFile(t, home, `{
+		"github_token": "file-token",
+		"preferred_languages": ["Rust"],
+		"max_repos": 10
+	}`)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if cfg.GitHubToken != "file-token" {
+		t.Errorf("GitHubToken = %q, want %q", cfg.GitHubToken, "file-token")
+	}
+	if !reflect.DeepEqual(cfg.PreferredLanguages, []string{"Rust"}) {
+		t.Errorf("PreferredLanguages = %v, want [Rust]", cfg.PreferredLanguages)
+	}
+	if cfg.MaxRepos != 10 {
+		t.Errorf("MaxRepos = %d, want 10", cfg.MaxRepos)
+	}
+
+	// Fields missing from the file keep their defaults
+	defaults := DefaultConfig()
+	if cfg.SkillLevel != defaults.SkillLevel {
+		t.Errorf("SkillLevel = %q, want default %q", cfg.SkillLevel, defaults.SkillLevel)
+	}
+	if cfg.MaxIssuesPerRepo != defaults.MaxIssuesPerRepo {
+		t.Errorf("MaxIssuesPerRepo = %d, want default %d", cfg.MaxIssuesPerRepo, defaults.MaxIssuesPerRepo)
+	}
+}
+
+func TestLoadEnvOverridesFile(t *testing.T) {
+	home := setupHome(t)
+	writeConfigFile(t, home, `{"github_token": "file-token"}`)
+	t.Setenv("GITHUB_TOKEN", "env-token")
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if cfg.GitHubToken != "env-token" {
+		t.Errorf("GitHubToken = %q, want %q", cfg.GitHubToken, "env-token")
+	}
+}
+
+func TestLoadMalformedFileFallsBackToDefaults(t *testing.T) {
+	home := setupHome(t)
+	writeConfigFile(t, home, `{"github_token": "file-token", "max_repos": `)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	// A malformed file is ignored entirely rather than partially applied
+	if !reflect.DeepEqual(cfg, DefaultConfig()) {
+		t.Errorf("expected defaults for malformed file, got %+v", cfg)
+	}
+}
+
+func TestSaveRoundTrip(t *testing.T) {
+	setupHome(t)
+
+	cfg := DefaultConfig()
+	cfg.GitHubToken = "saved-token"
+	cfg.MaxRepos = 7
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+
+	loaded, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+
+	if !reflect.DeepEqual(loaded, cfg) {
+		t.Errorf("round trip mismatch: got %+v, want %+v", loaded, cfg)
+	}
+}
+
+func TestLoadHonorsConfigEnvAndSavesBackToIt(t *testing.T) {
+	home := setupHome(t)
+	writeConfigFile(t, home, `{"max_repos": 10}`)
+
+	path := filepath.Join(t.TempDir(), "project.json")
+	if err := os.WriteFile(path, []byte(`{"max_repos": 3}`), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	t.Setenv(PathEnv, path)
+
+	cfg, err := Load()
+	if err != nil {
+		t.Fatalf("Load returned error: %v", err)
+	}
+	if cfg.MaxRepos != 3 {
+		t.Fatalf("MaxRepos = %d, want 3 from %s", cfg.MaxRepos, PathEnv)
+	}
+
+	cfg.MaxRepos = 5
+	if err := cfg.Save(); err != nil {
+		t.Fatalf("Save returned error: %v", err)
+	}
+	if cfg, err = LoadFrom(path); err != nil || cfg.MaxRepos != 5 {
+		t.Errorf("LoadFrom after Save = %+v, %v; want MaxRepos 5", cfg, err)
+	}
+}
+
+func TestLoadFromRejectsUnreadableOrMalformedFiles(t *testing.T) {
+	setupHome(t)
+
+	if _, err := LoadFrom(filepath.Join(t.TempDir(), "missing.json")); err == nil {
+		t.Error("LoadFrom of a missing file returned no error")
+	}
+
+	path := filepath.Join(t.TempDir(), "broken.json")
+	if err := os.WriteFile(path, []byte(`{"max_repos": `), 0600); err != nil {
+		t.Fatalf("failed to write config file: %v", err)
+	}
+	if _, err := LoadFrom(path); err == nil {
+		t.Error("LoadFrom of a malformed file returned no error")
+	}
+}
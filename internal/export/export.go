@@ -0,0 +1,206 @@
+package export
+
+import (
+	"encoding/csv"
+	"encoding/json"
+	"fmt"
+	"io"
+	"os"
+	"path/filepath"
+	"strconv"
+	"strings"
+	"time"
+
+	"hacktober/internal/github"
+)
+
+// reposCSVHeader names the columns written by ExportReposCSV
+var reposCSVHeader = []string{"owner", "name", "stars", "language", "relevance_score", "description", "url"}
+
+// GetExportsLocation returns the directory exports are written to
+func GetExportsLocation() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "exports")
+}
+
+// exportPath returns where an export taken at the given time is written,
+// e.g. ~/.hacktober/exports/repos-20241015-143000.csv for name "repos"
+func exportPath(name, ext string, at time.Time) string {
+	return filepath.Join(GetExportsLocation(), name+"-"+at.Format("20060102-150405")+"."+ext)
+}
+
+// writeExport creates a new timestamped export file under GetExportsLocation,
+// fills it with write and returns its path
+func writeExport(name, ext string, write func(io.Writer) error) (string, error) {
+	path := exportPath(name, ext, time.Now())
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return "", err
+	}
+
+	file, err := os.Create(path)
+	if err != nil {
+		return "", err
+	}
+	if err := write(file); err != nil {
+		file.Close()
+		return "", err
+	}
+	return path, file.Close()
+}
+
+// ExportReposCSV writes the repositories as CSV with a header row. Missing
+// fields are written as empty cells and nil repositories are skipped.
+func ExportReposCSV(w io.Writer, repos []*github.Repository) error {
+	writer := csv.NewWriter(w)
+	if err := writer.Write(reposCSVHeader); err != nil {
+		return err
+	}
+
+	for _, repo := range repos {
+		if repo == nil {
+			continue
+		}
+
+		stars := ""
+		if repo.Repository != nil && repo.StargazersCount != nil {
+			stars = strconv.Itoa(repo.GetStargazersCount())
+		}
+		record := []string{
+			repo.GetOwner().GetLogin(),
+			repo.GetName(),
+			stars,
+			repo.GetLanguage(),
+			strconv.Itoa(repo.RelevanceScore),
+			repo.GetDescription(),
+			repo.GetHTMLURL(),
+		}
+		if err := writer.Write(record); err != nil {
+			return err
+		}
+	}
+
+	writer.Flush()
+	return writer.Error()
+}
+
+// WriteReposCSV exports the repositories to a new timestamped file under
+// GetExportsLocation and returns its path
+func WriteReposCSV(repos []*github.Repository) (string, error) {
+	return writeExport("repos", "csv", func(w io.Writer) error {
+		return ExportReposCSV(w, repos)
+	})
+}
+
+// exportedIssue is one issue as written by ExportIssuesJSON
+type exportedIssue struct {
+	Number          int      `json:"number"`
+	Title           string   `json:"title"`
+	URL             string   `json:"url"`
+	Difficulty      string   `json:"difficulty"`
+	DifficultyScore int      `json:"difficulty_score"`
+	Labels          []string `json:"labels"`
+	Comments        int      `json:"comments"`
+	CreatedAt       string   `json:"created_at,omitempty"`
+}
+
+// issueLabels returns the names of an issue's labels, never nil
+func issueLabels(issue *github.Issue) []string {
+	labels := []string{}
+	for _, label := range issue.Issue.Labels {
+		labels = append(labels, label.GetName())
+	}
+	return labels
+}
+
+// ExportIssuesJSON writes the issues as an indented JSON array. Nil issues
+// are skipped.
+func ExportIssuesJSON(w io.Writer, issues []*github.Issue) error {
+	exported := make([]exportedIssue, 0, len(issues))
+	for _, issue := range issues {
+		if issue == nil || issue.Issue == nil {
+			continue
+		}
+
+		e := exportedIssue{
+			Number:          issue.Issue.GetNumber(),
+			Title:           issue.Issue.GetTitle(),
+			URL:             issue.Issue.GetHTMLURL(),
+			Difficulty:      github.DifficultyBand(issue.DifficultyScore),
+			DifficultyScore: issue.DifficultyScore,
+			Labels:          issueLabels(issue),
+			Comments:        issue.Issue.GetComments(),
+		}
+		if issue.Issue.CreatedAt != nil {
+			e.CreatedAt = issue.Issue.GetCreatedAt().Format(time.RFC3339)
+		}
+		exported = append(exported, e)
+	}
+
+	encoder := json.NewEncoder(w)
+	encoder.SetIndent("", "  ")
+	return encoder.Encode(exported)
+}
+
+// markdownCell escapes text for a markdown table cell
+func markdownCell(text string) string {
+	text = strings.ReplaceAll(text, "|", `\|`)
+	return strings.Join(strings.Fields(text), " ")
+}
+
+// ExportIssuesMarkdown writes the issues as a markdown table with the issue
+// number linking to it, title, difficulty, labels and comment count, under a
+// heading naming repo. repo may be nil for issues from several repositories.
+func ExportIssuesMarkdown(w io.Writer, repo *github.Repository, issues []*github.Issue) error {
+	heading := "Issues"
+	if repo != nil && repo.Repository != nil {
+		heading = fmt.Sprintf("Issues in %s/%s", repo.GetOwner().GetLogin(), repo.GetName())
+	}
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "## %s\n\n", heading)
+	b.WriteString("| Issue | Title | Difficulty | Labels | Comments |\n")
+	b.WriteString("| --- | --- | --- | --- | ---: |\n")
+	for _, issue := range issues {
+		if issue == nil || issue.Issue == nil {
+			continue
+		}
+
+		number := fmt.Sprintf("#%d", issue.Issue.GetNumber())
+		if url := issue.Issue.GetHTMLURL(); url != "" {
+			number = fmt.Sprintf("[%s](%s)", number, url)
+		}
+		fmt.Fprintf(&b, "| %s | %s | %s | %s | %d |\n",
+			number,
+			markdownCell(issue.Issue.GetTitle()),
+			github.DifficultyBand(issue.DifficultyScore),
+			markdownCell(strings.Join(issueLabels(issue), ", ")),
+			issue.Issue.GetComments())
+	}
+
+	_, err := io.WriteString(w, b.String())
+	return err
+}
+
+// issuesExportName names an issue export after its repository, if any
+func issuesExportName(repo *github.Repository) string {
+	if repo == nil || repo.Repository == nil {
+		return "issues"
+	}
+	return fmt.Sprintf("issues-%s-%s", repo.GetOwner().GetLogin(), repo.GetName())
+}
+
+// WriteIssuesJSON exports the issues as JSON to a new timestamped file under
+// GetExportsLocation and returns its path
+func WriteIssuesJSON(repo *github.Repository, issues []*github.Issue) (string, error) {
+	return writeExport(issuesExportName(repo), "json", func(w io.Writer) error {
+		return ExportIssuesJSON(w, issues)
+	})
+}
+
+// WriteIssuesMarkdown exports the issues as a markdown table to a new
+// timestamped file under GetExportsLocation and returns its path
+func WriteIssuesMarkdown(repo *github.Repository, issues []*github.Issue) (string, error) {
+	return writeExport(issuesExportName(repo), "md", func(w io.Writer) error {
+		return ExportIssuesMarkdown(w, repo, issues)
+	})
+}
@@ -0,0 +1,96 @@
+package export
+
+import (
+	"encoding/json"
+	"strings"
+	"testing"
+
+	gh "github.com/google/go-github/v56/github"
+
+	"hacktober/internal/github"
+)
+
+func TestExportReposCSVLeavesMissingFieldsEmpty(t *testing.T) {
+	repos := []*github.Repository{
+		{
+			Repository: &gh.Repository{
+				Owner:           &gh.User{Login: gh.String("octo")},
+				Name:            gh.String("docs"),
+				StargazersCount: gh.Int(42),
+				Language:        gh.String("Go"),
+				Description:     gh.String("Docs, with a comma"),
+				HTMLURL:         gh.String("https://github.com/octo/docs"),
+			},
+			RelevanceScore: 7,
+		},
+		{Repository: &gh.Repository{Name: gh.String("bare")}},
+		{},
+		nil,
+	}
+
+	var out strings.Builder
+	if err := ExportReposCSV(&out, repos); err != nil {
+		t.Fatalf("ExportReposCSV returned error: %v", err)
+	}
+
+	want := "owner,name,stars,language,relevance_score,description,url\n" +
+		"octo,docs,42,Go,7,\"Docs, with a comma\",https://github.com/octo/docs\n" +
+		",bare,,,0,,\n" +
+		",,,,0,,\n"
+	if out.String() != want {
+		t.Errorf("ExportReposCSV wrote\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func testIssues() []*github.Issue {
+	return []*github.Issue{
+		{
+			Issue: &gh.Issue{
+				Number:   gh.Int(12),
+				Title:    gh.String("Fix | in table"),
+				HTMLURL:  gh.String("https://github.com/octo/docs/issues/12"),
+				Comments: gh.Int(3),
+				Labels:   []*gh.Label{{Name: gh.String("good first issue")}, {Name: gh.String("docs")}},
+			},
+			DifficultyScore: 20,
+		},
+		{Issue: &gh.Issue{Number: gh.Int(13)}, DifficultyScore: 70},
+		nil,
+	}
+}
+
+func TestExportIssuesMarkdownWritesLinkedTable(t *testing.T) {
+	repo := &github.Repository{Repository: &gh.Repository{
+		Owner: &gh.User{Login: gh.String("octo")},
+		Name:  gh.String("docs"),
+	}}
+
+	var out strings.Builder
+	if err := ExportIssuesMarkdown(&out, repo, testIssues()); err != nil {
+		t.Fatalf("ExportIssuesMarkdown returned error: %v", err)
+	}
+
+	want := "## Issues in octo/docs\n\n" +
+		"| Issue | Title | Difficulty | Labels | Comments |\n" +
+		"| --- | --- | --- | --- | ---: |\n" +
+		"| [#12](https://github.com/octo/docs/issues/12) | Fix \\| in table | Easy | good first issue, docs | 3 |\n" +
+		"| #13 |  | Hard |  | 0 |\n"
+	if out.String() != want {
+		t.Errorf("ExportIssuesMarkdown wrote\n%s\nwant\n%s", out.String(), want)
+	}
+}
+
+func TestExportIssuesJSONSkipsNilIssues(t *testing.T) {
+	var out strings.Builder
+	if err := ExportIssuesJSON(&out, testIssues()); err != nil {
+		t.Fatalf("ExportIssuesJSON returned error: %v", err)
+	}
+
+	var exported []exportedIssue
+	if err := json.Unmarshal([]byte(out.String()), &exported); err != nil {
+		t.Fatalf("ExportIssuesJSON wrote invalid JSON: %v", err)
+	}
+	if len(exported) != 2 || exported[0].Difficulty != "Easy" || len(exported[0].Labels) != 2 || exported[1].Labels == nil {
+		t.Errorf("ExportIssuesJSON wrote %+v", exported)
+	}
+}
@@ -0,0 +1,55 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// GetCommitActivity returns a repository's weekly commit counts over the last
+// year, oldest week first. Results are cached per repository. GitHub computes
+// the statistics lazily, so the first request for a repository may fail
+// asking to try again shortly.
+func (c *Client) GetCommitActivity(owner, repo string) ([]int, error) {
+	repoName := fmt.Sprintf("%s/%s", owner, repo)
+
+	c.mu.Lock()
+	cached, ok := c.activityCache[repoName]
+	c.mu.Unlock()
+	if ok {
+		logger.Debug(fmt.Sprintf("Commit activity cache hit for %s", repoName))
+		return cached, nil
+	}
+
+	start := time.Now()
+	ctx, cancel := c.requestContext(c.ctx)
+	defer cancel()
+
+	weeks, response, err := c.client.Repositories.ListCommitActivity(ctx, owner, repo)
+	if response != nil {
+		logger.LogAPIRequest("repos/stats/commit_activity", repoName, response.StatusCode, time.Since(start))
+	}
+	var accepted *github.AcceptedError
+	if errors.As(err, &accepted) {
+		return nil, fmt.Errorf("GitHub is still computing commit activity for %s, try again shortly", repoName)
+	}
+	if err != nil {
+		err = c.describeTimeout(err, c.ctx)
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch commit activity for %s", repoName), err)
+		return nil, fmt.Errorf("failed to fetch commit activity: %w", err)
+	}
+
+	counts := make([]int, len(weeks))
+	for i, week := range weeks {
+		counts[i] = week.GetTotal()
+	}
+
+	c.mu.Lock()
+	c.activityCache[repoName] = counts
+	c.mu.Unlock()
+	return counts, nil
+}
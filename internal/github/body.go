@@ -0,0 +1,38 @@
+package github
+
+import (
+	"fmt"
+	"unicode/utf8"
+
+	"hacktober/internal/logger"
+)
+
+// SetMaxIssueBodyBytes limits how much of each issue body is kept in memory;
+// 0 or less keeps bodies whole
+func (c *Client) SetMaxIssueBodyBytes(limit int) {
+	c.mu.Lock()
+	c.maxBodyBytes = limit
+	c.mu.Unlock()
+}
+
+// trimBody cuts an issue body down to the configured size at ingestion,
+// backing off to a rune boundary, and records that it was cut
+func (c *Client) trimBody(issue *Issue) {
+	c.mu.Lock()
+	limit := c.maxBodyBytes
+	c.mu.Unlock()
+
+	body := issue.Issue.GetBody()
+	if limit <= 0 || len(body) <= limit {
+		return
+	}
+
+	cut := limit
+	for cut > 0 && !utf8.RuneStart(body[cut]) {
+		cut--
+	}
+	trimmed := body[:cut]
+	issue.Issue.Body = &trimmed
+	issue.BodyTruncated = true
+	logger.Debug(fmt.Sprintf("Truncated body of issue #%d from %d to %d bytes", issue.Issue.GetNumber(), len(body), cut))
+}
@@ -2,8 +2,12 @@ package github
 
 import (
 	"context"
+	"errors"
 	"fmt"
+	"net/http"
+	"sort"
 	"strings"
+	"sync"
 	"time"
 
 	"hacktober/internal/logger"
@@ -16,6 +20,51 @@ import (
 type Client struct {
 	client *github.Client
 	ctx    context.Context
+	// cancel ends ctx, aborting requests and rate limit waits; see Close
+	cancel context.CancelFunc
+
+	// readmeCache stores decoded README excerpts keyed by "owner/repo"
+	readmeCache map[string]string
+	// issueCache stores fully fetched issues keyed by "owner/repo#number"
+	issueCache map[string]*Issue
+	// readinessCache stores contribution readiness keyed by "owner/repo"
+	readinessCache map[string]*Readiness
+	// activityCache stores weekly commit counts keyed by "owner/repo"
+	activityCache map[string][]int
+	// responseCache stores time to first response keyed by "owner/repo#number"
+	responseCache map[string]*time.Duration
+	// repoCandidates holds the ranked result of the last repository search,
+	// keyed by its criteria, so pages can be sliced from a stable order
+	repoCandidates     []*Repository
+	repoCandidatesKey  string
+	repoTotalAvailable int
+	repoBroadened      bool
+	repoIncomplete     bool
+	// familiar holds the user's starred and contributed repositories, see
+	// familiarRepos
+	familiar map[string]Familiarity
+	// results reuses search pages and issue lists for a while, nil when
+	// disabled; see NewClientWithCache
+	results *resultCache
+	mu      sync.Mutex
+
+	// requestTimeout bounds each API call, searchTimeout a whole repository
+	// search; see SetTimeouts
+	requestTimeout time.Duration
+	searchTimeout  time.Duration
+
+	// difficultyRules override the difficulty heuristics, see SetDifficultyLabels
+	difficultyRules []difficultyLabelRule
+	// unknownDifficulty scores issues with nothing to judge them by as
+	// UnknownDifficulty, see SetUnknownDifficulty
+	unknownDifficulty bool
+	// maxBodyBytes bounds the issue bodies kept, see SetMaxIssueBodyBytes
+	maxBodyBytes int
+	// totalRetries is how often the global count query is retried, see
+	// SetTotalCountRetries
+	totalRetries int
+	// retry bounds waiting out rate limits, see SetRateLimitRetries
+	retry retryConfig
 }
 
 // Repository represents a GitHub repository with additional metadata
@@ -23,6 +72,20 @@ type Repository struct {
 	*github.Repository
 	RelevanceScore int
 	Languages      []string
+	Pinned         bool
+	Readiness      *Readiness // nil until set from ReadinessOf
+	Broadened      bool       // found only by widening a search that returned too few results
+	Dependency     bool       // listed in RepoSearchOptions.Dependencies
+	Starred        bool       // starred by the user, see RepoSearchOptions.Familiar
+	Contributed    bool       // the user has opened pull requests against it
+	// RelevanceFactors breaks RelevanceScore down into its contributions
+	RelevanceFactors []RelevanceFactor
+}
+
+// RelevanceFactor is one contribution to a repository's relevance score
+type RelevanceFactor struct {
+	Reason string
+	Points int
 }
 
 // Issue represents a GitHub issue with additional metadata
@@ -31,73 +94,637 @@ type Issue struct {
 	Repository      *Repository
 	DifficultyScore int
 	RelevanceScore  int
+	BodyTruncated   bool // the body was cut at ingestion, see SetMaxIssueBodyBytes
+	// FirstResponse is how long the issue waited for a comment from someone
+	// other than its author, nil when unknown; see EnrichFirstResponses
+	FirstResponse *time.Duration
+	Commented     bool // the user has commented on it; see IssueFilter.MarkCommented
+	Assigned      bool // someone is assigned to it, so it is likely claimed
+	AssigneeCount int
+}
+
+// newIssue wraps an API issue, recording whether it is assigned
+func newIssue(issue *github.Issue) *Issue {
+	return &Issue{
+		Issue:         issue,
+		Assigned:      len(issue.Assignees) > 0,
+		AssigneeCount: len(issue.Assignees),
+	}
+}
+
+// RepoSearchOptions describes the criteria for a repository search
+type RepoSearchOptions struct {
+	MinStars    int
+	MaxStars    int // 0 means no upper bound
+	Languages   []string
+	PinnedRepos []string // "owner/name" repositories floated to the top of the results
+	FetchPinned bool     // fetch pinned repositories missing from the results directly
+	Weights     RelevanceWeights
+	// MinOpenIssues drops repositories with fewer open issues, 0 disables.
+	// GitHub's open issue count includes open pull requests, so this is an
+	// upper bound on the real number of issues. Pinned repositories are kept.
+	MinOpenIssues int
+	// Broaden widens a search returning fewer than a quarter of a page of
+	// results to FallbackLanguages, or to every language if that is empty
+	Broaden           bool
+	FallbackLanguages []string
+	// OwnerType keeps only repositories owned by an organization ("org") or
+	// a personal account ("user"); empty keeps both
+	OwnerType string
+	// IncludeArchived keeps archived repositories, which are otherwise
+	// excluded both in the query and when processing the results
+	IncludeArchived bool
+	// Dependencies are "owner/name" repositories the user's own projects
+	// depend on, boosted by Weights.DependencyBonus
+	Dependencies []string
+	// Topic narrows the search to hacktoberfest repositories that also
+	// carry this topic
+	Topic string
+	// Familiar looks up the repositories the user has starred or contributed
+	// to and marks them, boosted by Weights.FamiliarBonus
+	Familiar bool
+	// DiscoveryMode finds repositories by the hacktoberfest topic ("topic",
+	// the default), by their open issues labelled hacktoberfest ("label"),
+	// or both merged
+	DiscoveryMode string
+	// PushedWithinDays keeps only repositories pushed to in the last this
+	// many days, 0 disables
+	PushedWithinDays int
+
+	// familiar is filled in from Familiar when the search runs
+	familiar map[string]Familiarity
+}
+
+// skipRepository reports whether a search result falls outside the star
+// range, is archived, has the wrong kind of owner or too little open work,
+// logging why
+func (o RepoSearchOptions) skipRepository(repo *github.Repository, repoKey string) bool {
+	// Skip repositories above the star range
+	if o.MaxStars > 0 && repo.GetStargazersCount() > o.MaxStars {
+		logger.Debug(fmt.Sprintf("Repository %s has %d stars, above %d, skipping", repoKey, repo.GetStargazersCount(), o.MaxStars))
+		return true
+	}
+
+	// Skip archived repositories
+	if !o.IncludeArchived && repo.GetArchived() {
+		logger.Debug(fmt.Sprintf("Repository %s is archived, skipping", repoKey))
+		return true
+	}
+
+	// Skip repositories owned by the other kind of account
+	if want := ownerTypes[strings.ToLower(o.OwnerType)]; want != "" && repo.GetOwner().GetType() != want {
+		logger.Debug(fmt.Sprintf("Repository %s is owned by a %s, skipping", repoKey, repo.GetOwner().GetType()))
+		return true
+	}
+
+	// Skip repositories nobody pushed to recently
+	if o.PushedWithinDays > 0 && repo.GetPushedAt().Before(o.pushedSince()) {
+		logger.Debug(fmt.Sprintf("Repository %s was last pushed %s, before the last %d days, skipping",
+			repoKey, repo.GetPushedAt().Format("2006-01-02"), o.PushedWithinDays))
+		return true
+	}
+
+	// Skip repositories with too little open work
+	if o.MinOpenIssues > 0 && repo.GetOpenIssuesCount() < o.MinOpenIssues {
+		logger.Debug(fmt.Sprintf("Repository %s has %d open issues (including PRs), below %d, skipping",
+			repoKey, repo.GetOpenIssuesCount(), o.MinOpenIssues))
+		return true
+	}
+	return false
+}
+
+// pushedSince returns the earliest push date PushedWithinDays allows
+func (o RepoSearchOptions) pushedSince() time.Time {
+	return time.Now().AddDate(0, 0, -o.PushedWithinDays)
+}
+
+// dependsOn reports whether fullName is one of the user's dependencies
+func (o RepoSearchOptions) dependsOn(fullName string) bool {
+	for _, dependency := range o.Dependencies {
+		if strings.EqualFold(dependency, fullName) {
+			return true
+		}
+	}
+	return false
+}
+
+// newRepository wraps a search result, marking whether the user depends on,
+// starred or contributed to it
+func (o RepoSearchOptions) newRepository(repo *github.Repository, fullName string) *Repository {
+	familiar := o.familiar[strings.ToLower(fullName)]
+	return &Repository{
+		Repository:  repo,
+		Dependency:  o.dependsOn(fullName),
+		Starred:     familiar.Starred,
+		Contributed: familiar.Contributed,
+	}
+}
+
+// Owner types accepted by RepoSearchOptions.OwnerType, mapped to GitHub's
+// owner type names
+var ownerTypes = map[string]string{"org": "Organization", "user": "User"}
+
+// StarScoring controls the stars component of the relevance score, which is
+// min(Cap, stars/Divisor). Zero (unset) or negative values fall back to
+// DefaultStarScoring.
+type StarScoring struct {
+	Cap     int
+	Divisor int
+}
+
+// DefaultStarScoring gives every 10 stars a point, up to 100 points
+var DefaultStarScoring = StarScoring{Cap: 100, Divisor: 10}
+
+// validated returns the scoring with non-positive values replaced by
+// defaults, warning about negative ones since those can only be a mistake
+func (s StarScoring) validated() StarScoring {
+	if s.Cap <= 0 {
+		if s.Cap < 0 {
+			logger.Warn(fmt.Sprintf("Ignoring negative star score cap %d, using %d", s.Cap, DefaultStarScoring.Cap))
+		}
+		s.Cap = DefaultStarScoring.Cap
+	}
+	if s.Divisor <= 0 {
+		if s.Divisor < 0 {
+			logger.Warn(fmt.Sprintf("Ignoring negative star score divisor %d, using %d", s.Divisor, DefaultStarScoring.Divisor))
+		}
+		s.Divisor = DefaultStarScoring.Divisor
+	}
+	return s
+}
+
+// points returns the stars contribution for a repository with the given stars
+func (s StarScoring) points(stars int) int {
+	return min(s.Cap, stars/s.Divisor)
 }
 
+// RelevanceWeights tunes the relevance score. RecentBonus is awarded to
+// repositories updated within the last month and LanguageBonus to a match on
+// the first preferred language, with later languages earning progressively
+// less. Zero bonuses are allowed and switch the factor off.
+type RelevanceWeights struct {
+	Stars         StarScoring
+	RecentBonus   int
+	LanguageBonus int
+	OrgBonus      int // awarded to repositories owned by an organization
+	// DependencyBonus is awarded to repositories the user's projects depend on
+	DependencyBonus int
+	// FamiliarBonus is awarded to repositories the user starred or contributed to
+	FamiliarBonus int
+}
+
+// DefaultRelevanceWeights are the weights used when none are configured
+var DefaultRelevanceWeights = RelevanceWeights{Stars: DefaultStarScoring, RecentBonus: 20, LanguageBonus: 50}
+
+// DefaultOrgBonus is the organization bonus used when orgs are preferred
+const DefaultOrgBonus = 15
+
+// validated returns the weights with invalid values replaced by defaults
+func (w RelevanceWeights) validated() RelevanceWeights {
+	w.Stars = w.Stars.validated()
+	if w.RecentBonus < 0 {
+		logger.Warn(fmt.Sprintf("Ignoring negative recent activity bonus %d, using %d", w.RecentBonus, DefaultRelevanceWeights.RecentBonus))
+		w.RecentBonus = DefaultRelevanceWeights.RecentBonus
+	}
+	if w.LanguageBonus < 0 {
+		logger.Warn(fmt.Sprintf("Ignoring negative language bonus %d, using %d", w.LanguageBonus, DefaultRelevanceWeights.LanguageBonus))
+		w.LanguageBonus = DefaultRelevanceWeights.LanguageBonus
+	}
+	if w.OrgBonus < 0 {
+		logger.Warn(fmt.Sprintf("Ignoring negative organization bonus %d", w.OrgBonus))
+		w.OrgBonus = 0
+	}
+	if w.DependencyBonus < 0 {
+		logger.Warn(fmt.Sprintf("Ignoring negative dependency bonus %d", w.DependencyBonus))
+		w.DependencyBonus = 0
+	}
+	if w.FamiliarBonus < 0 {
+		logger.Warn(fmt.Sprintf("Ignoring negative familiar repository bonus %d", w.FamiliarBonus))
+		w.FamiliarBonus = 0
+	}
+	return w
+}
+
+// RepoSearchResult contains one page of ranked repositories
+type RepoSearchResult struct {
+	Repositories   []*Repository
+	TotalAvailable int  // global count of Hacktoberfest repos, ignoring language filters, or UnknownTotal
+	CandidateCount int  // number of ranked repos available for local paging
+	Broadened      bool // the search was widened beyond the preferred languages
+	Incomplete     bool // GitHub timed out on a search and returned partial results
+	APICalls       int  // requests this page cost, 0 when sliced from cached candidates
+}
+
+// candidatesPerLanguage is how many repositories are fetched per language
+// search to build the candidate set that pages are sliced from
+const candidatesPerLanguage = 100
+
+// repoSearchOptions returns the options for a repository search. The sort is
+// only specified here, never as a sort: qualifier in the query, so GitHub has
+// a single ordering to page through.
+func repoSearchOptions(perPage int) *github.SearchOptions {
+	return &github.SearchOptions{
+		Sort:        "stars",
+		Order:       "desc",
+		ListOptions: github.ListOptions{Page: 1, PerPage: perPage},
+	}
+}
+
+// Language preference bonus bounds used by calculateRelevance, below the
+// configured bonus for the first language
+const (
+	minLanguageBonus  = 10
+	languageBonusStep = 10
+)
+
 // IssueStats contains statistics about issues in a repository
 type IssueStats struct {
-	Issues      []*Issue
-	LabelCounts map[string]int
-	TotalIssues int
+	Issues         []*Issue
+	LabelCounts    map[string]int
+	TotalIssues    int
+	ExcludedCount  int // issues dropped by IssueFilter.ExcludeLabels
+	TakenCount     int // issues dropped by IssueFilter.ExcludeWithOpenPRs
+	DiscussedCount int // issues dropped by IssueFilter.MaxComments
+	CommentedCount int // issues dropped by IssueFilter.ExcludeCommented
+	AssignedCount  int // issues dropped by IssueFilter.ExcludeAssigned
+	APICalls       int // requests the load cost, including linked PR checks
+}
+
+// IssueFilter controls the order issues are fetched in and which fetched
+// issues are dropped before they are returned
+type IssueFilter struct {
+	ExcludeLabels      []string // case-insensitive label names to filter out
+	ExcludeWithOpenPRs bool     // drop issues with an open linked PR, costs one API call per issue
+	MaxComments        int      // drop issues with more comments than this, 0 disables
+	ExcludeCommented   bool     // drop issues the user has commented on, costs one search per load
+	ExcludeAssigned    bool     // drop issues someone is assigned to
+	MarkCommented      bool     // look up the issues the user has commented on and set Issue.Commented
+	Sort               string   // server-side order: created, updated or comments; defaults to updated
+	Direction          string   // asc or desc; defaults to desc
+	LabelMatch         string   // "all" (default) needs every requested label, "any" runs one query per label
+}
+
+// Allowed server-side issue orderings, see the GitHub "list repository issues" API
+var (
+	issueSorts      = map[string]bool{"created": true, "updated": true, "comments": true}
+	issueDirections = map[string]bool{"asc": true, "desc": true}
+)
+
+// matchAnyLabel reports whether issues carrying any one of the requested
+// labels are wanted, rather than issues carrying all of them
+func (f IssueFilter) matchAnyLabel() bool {
+	switch strings.ToLower(f.LabelMatch) {
+	case "any":
+		return true
+	case "", "all":
+		return false
+	}
+	logger.Warn(fmt.Sprintf("Ignoring unknown label match mode %q, using all", f.LabelMatch))
+	return false
+}
+
+// issueOrder returns the validated sort and direction, falling back to the
+// most recently updated issues first
+func (f IssueFilter) issueOrder() (string, string) {
+	field, direction := strings.ToLower(f.Sort), strings.ToLower(f.Direction)
+	if !issueSorts[field] {
+		if field != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown issue sort %q, using updated", f.Sort))
+		}
+		field = "updated"
+	}
+	if !issueDirections[direction] {
+		if direction != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown issue sort direction %q, using desc", f.Direction))
+		}
+		direction = "desc"
+	}
+	return field, direction
 }
 
 // NewClient creates a new GitHub API client
 func NewClient(token string) *Client {
-	ctx := context.Background()
+	ctx, cancel := context.WithCancel(context.Background())
 	ts := oauth2.StaticTokenSource(
 		&oauth2.Token{AccessToken: token},
 	)
 	tc := oauth2.NewClient(ctx, ts)
+	tc.Transport = countingTransport{base: tc.Transport}
 
 	return &Client{
-		client: github.NewClient(tc),
-		ctx:    ctx,
+		client:         github.NewClient(tc),
+		ctx:            ctx,
+		cancel:         cancel,
+		readmeCache:    make(map[string]string),
+		issueCache:     make(map[string]*Issue),
+		readinessCache: make(map[string]*Readiness),
+		activityCache:  make(map[string][]int),
+		responseCache:  make(map[string]*time.Duration),
 	}
 }
 
-// SearchHacktoberfestRepos searches for Hacktoberfest repositories with minimum stars
 // SearchHacktoberfestRepos searches for Hacktoberfest repositories with minimum stars.
 // It now returns both the collected repositories (limited by maxResults) and the
 // total number of Hacktoberfest repositories matching the base criteria (without
 // language filters) so the UI can show users how many exist in total.
 func (c *Client) SearchHacktoberfestRepos(minStars int, languages []string, maxResults int) ([]*Repository, int, error) {
-	return c.SearchHacktoberfestReposWithPage(minStars, languages, maxResults, 1)
+	opts := RepoSearchOptions{MinStars: minStars, Languages: languages}
+	result, err := c.SearchHacktoberfestReposWithPage(opts, maxResults, 1)
+	if err != nil {
+		return nil, 0, err
+	}
+	return result.Repositories, result.TotalAvailable, nil
+}
+
+// starsQualifier returns the search qualifier for a star range: a range when
+// both bounds are set, a single bound when only one is, and nothing when
+// neither is
+func starsQualifier(minStars, maxStars int) string {
+	switch {
+	case minStars > 0 && maxStars > 0:
+		return fmt.Sprintf(" stars:%d..%d", minStars, maxStars)
+	case minStars > 0:
+		return fmt.Sprintf(" stars:>=%d", minStars)
+	case maxStars > 0:
+		return fmt.Sprintf(" stars:<=%d", maxStars)
+	}
+	return ""
 }
 
 // SearchHacktoberfestReposWithPage searches for Hacktoberfest repositories with pagination support.
-func (c *Client) SearchHacktoberfestReposWithPage(minStars int, languages []string, maxResults int, page int) ([]*Repository, int, error) {
+// The full candidate set is fetched and ranked once per set of search criteria and
+// then sliced into stable local pages, so paging back and forth always returns the
+// same items. Call ClearRepoSearchCache to force a fresh search.
+func (c *Client) SearchHacktoberfestReposWithPage(opts RepoSearchOptions, maxResults int, page int) (*RepoSearchResult, error) {
 	start := time.Now()
+	languages := opts.Languages
+	opts.Weights = opts.Weights.validated()
+	if opts.OwnerType != "" && ownerTypes[strings.ToLower(opts.OwnerType)] == "" {
+		logger.Warn(fmt.Sprintf("Ignoring unknown owner type %q, showing every owner", opts.OwnerType))
+		opts.OwnerType = ""
+	}
 	logger.Info(fmt.Sprintf("Starting repository search with languages: %v, page: %d", languages, page))
 
+	resultKey := searchCacheKey(opts, maxResults, page)
+	if result, ok := c.results.search(resultKey); ok {
+		logCacheHit(fmt.Sprintf("repository search with languages: %v, page: %d", languages, page))
+		return result, nil
+	}
+
+	cacheKey := fmt.Sprintf("%v", opts)
+
+	c.mu.Lock()
+	candidates, totalAvailable, broadened, ok := c.repoCandidates, c.repoTotalAvailable, c.repoBroadened, c.repoCandidatesKey == cacheKey
+	incomplete := c.repoIncomplete
+	c.mu.Unlock()
+
+	apiCalls := 0
+	if ok {
+		logger.Debug(fmt.Sprintf("Using %d cached repository candidates for page %d", len(candidates), page))
+	} else {
+		searchCtx, cancel := c.searchContext()
+		defer cancel()
+		ctx, calls := countAPICalls(searchCtx)
+		if opts.Familiar {
+			opts.familiar = c.familiarRepos(ctx)
+		}
+
+		var err error
+		candidates, totalAvailable, incomplete, err = c.fetchRepoCandidates(ctx, opts, languages)
+		var invalid *InvalidQueryError
+		if errors.As(err, &invalid) {
+			return nil, invalid // already phrased for the user
+		} else if err != nil {
+			return nil, fmt.Errorf("failed to search repositories: %w", err)
+		}
+		if opts.Broaden && len(candidates) < maxResults/4 {
+			var extraIncomplete bool
+			candidates, broadened, extraIncomplete = c.broadenCandidates(ctx, opts, candidates)
+			incomplete = incomplete || extraIncomplete
+		}
+		candidates = c.pinRepositories(ctx, candidates, opts)
+		apiCalls = int(calls.Load())
+
+		c.mu.Lock()
+		c.repoCandidates = candidates
+		c.repoTotalAvailable = totalAvailable
+		c.repoBroadened = broadened
+		c.repoIncomplete = incomplete
+		c.repoCandidatesKey = cacheKey
+		c.mu.Unlock()
+	}
+
+	// Slice the ranked candidates into the requested page
+	first := (max(page, 1) - 1) * maxResults
+	last := min(first+maxResults, len(candidates))
+	var pageRepos []*Repository
+	if first < len(candidates) {
+		pageRepos = candidates[first:last]
+	}
+
+	duration := time.Since(start)
+	logger.LogRepoSearch(fmt.Sprintf("languages: %v", languages), len(candidates), len(pageRepos), languages)
+	logger.Info(fmt.Sprintf("Repository search completed: %d returned for page %d (limit %d), %d candidates, global total: %d, %d API calls, took %v",
+		len(pageRepos), page, maxResults, len(candidates), totalAvailable, apiCalls, duration))
+
+	result := &RepoSearchResult{
+		Repositories:   pageRepos,
+		TotalAvailable: totalAvailable,
+		CandidateCount: len(candidates),
+		Broadened:      broadened,
+		Incomplete:     incomplete,
+		APICalls:       apiCalls,
+	}
+	c.results.storeSearch(resultKey, result)
+	return result, nil
+}
+
+// ClearRepoSearchCache discards the ranked repository candidates so the next
+// search queries GitHub again
+func (c *Client) ClearRepoSearchCache() {
+	c.mu.Lock()
+	defer c.mu.Unlock()
+
+	c.repoCandidates = nil
+	c.repoTotalAvailable = 0
+	c.repoBroadened = false
+	c.repoIncomplete = false
+	c.repoCandidatesKey = ""
+	c.results.clearSearches()
+}
+
+// broadenCandidates widens a search that found too few repositories to
+// opts.FallbackLanguages, or to every language if none are configured. The
+// extra repositories are marked as broadened and ranked in with the original
+// candidates, still scored against the preferred languages. It also reports
+// whether GitHub returned partial results for the broadened searches.
+func (c *Client) broadenCandidates(ctx context.Context, opts RepoSearchOptions, candidates []*Repository) ([]*Repository, bool, bool) {
+	logger.Info(fmt.Sprintf("Only %d repositories found, broadening search to languages: %v", len(candidates), opts.FallbackLanguages))
+
+	extra, _, incomplete, err := c.fetchRepoCandidates(ctx, opts, opts.FallbackLanguages)
+	if err != nil {
+		logger.ErrorWithErr("Broadened repository search failed, keeping original results", err)
+		return candidates, false, false
+	}
+
+	seen := make(map[string]bool, len(candidates))
+	for _, repo := range candidates {
+		seen[repoFullName(repo.Repository)] = true
+	}
+
+	merged := append([]*Repository(nil), candidates...)
+	for _, repo := range extra {
+		if !seen[repoFullName(repo.Repository)] {
+			repo.Broadened = true
+			merged = append(merged, repo)
+		}
+	}
+	if len(merged) == len(candidates) {
+		return candidates, false, incomplete
+	}
+
+	sort.SliceStable(merged, func(i, j int) bool {
+		return merged[i].rankedBefore(merged[j])
+	})
+
+	logger.Info(fmt.Sprintf("Broadened search added %d repositories", len(merged)-len(candidates)))
+	return merged, true, incomplete
+}
+
+// pinRepositories moves the pinned repositories to the top of the ranked
+// candidates, in the order they were pinned. Pinned repositories missing from
+// the candidates are fetched directly when opts.FetchPinned is set.
+func (c *Client) pinRepositories(ctx context.Context, candidates []*Repository, opts RepoSearchOptions) []*Repository {
+	if len(opts.PinnedRepos) == 0 {
+		return candidates
+	}
+
+	byName := make(map[string]*Repository, len(candidates))
+	for _, repo := range candidates {
+		byName[strings.ToLower(repoFullName(repo.Repository))] = repo
+	}
+
+	pinned := make([]*Repository, 0, len(opts.PinnedRepos))
+	for _, fullName := range opts.PinnedRepos {
+		key := strings.ToLower(fullName)
+		repo, found := byName[key]
+		if !found && opts.FetchPinned {
+			repo = c.fetchPinnedRepository(ctx, fullName, opts)
+		}
+		if repo == nil || repo.Pinned {
+			continue
+		}
+
+		repo.Pinned = true
+		pinned = append(pinned, repo)
+	}
+
+	logger.Info(fmt.Sprintf("Pinned %d of %d configured repositories", len(pinned), len(opts.PinnedRepos)))
+
+	result := make([]*Repository, 0, len(candidates)+len(pinned))
+	result = append(result, pinned...)
+	for _, repo := range candidates {
+		if !repo.Pinned {
+			result = append(result, repo)
+		}
+	}
+
+	return result
+}
+
+// fetchPinnedRepository fetches a single "owner/name" repository that did not
+// appear in the search results. It returns nil if it cannot be fetched.
+func (c *Client) fetchPinnedRepository(ctx context.Context, fullName string, opts RepoSearchOptions) *Repository {
+	owner, name, ok := strings.Cut(fullName, "/")
+	if !ok {
+		logger.Warn(fmt.Sprintf("Ignoring malformed pinned repository: %s", fullName))
+		return nil
+	}
+
+	reqCtx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	start := time.Now()
+	repo, response, err := c.client.Repositories.Get(reqCtx, owner, name)
+	if response != nil {
+		logger.LogAPIRequest("repos/get", fullName, response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch pinned repository %s", fullName), c.describeTimeout(err, ctx))
+		return nil
+	}
+
+	r := opts.newRepository(repo, fullName)
+	r.calculateRelevance(opts.Languages, opts.Weights)
+	return r
+}
+
+// fetchRepoCandidates runs the language searches, deduplicates the results and
+// ranks them by relevance against the preferred languages in opts. It searches
+// searchLanguages, or every language when that is empty, and also returns the
+// global Hacktoberfest repo count and whether GitHub timed out on any of the
+// searches and returned partial results. opts.DiscoveryMode picks whether the
+// topic searches, the labelled issue searches or both run; without the topic
+// searches the global count is UnknownTotal.
+// A failed language search is skipped, but once ctx expires the search stops
+// with an error; it also fails if every language search timed out.
+func (c *Client) fetchRepoCandidates(ctx context.Context, opts RepoSearchOptions, searchLanguages []string) ([]*Repository, int, bool, error) {
+	start := time.Now()
+	minStars, languages := opts.MinStars, searchLanguages
+
 	var allRepos []*Repository
 	repoMap := make(map[string]*Repository) // To deduplicate repos
+	mode := opts.discoveryMode()
 
 	// If no languages specified, search without language filter
 	if len(languages) == 0 {
 		languages = []string{""}
 	}
+	topicLanguages := languages
+	if mode == "label" {
+		topicLanguages = nil
+	}
+
+	qualifiers := " archived:false"
+	if opts.IncludeArchived {
+		qualifiers = ""
+	}
+	if opts.Topic != "" {
+		qualifiers += " topic:" + opts.Topic
+	}
+	if opts.PushedWithinDays > 0 {
+		qualifiers += " pushed:>=" + opts.pushedSince().Format("2006-01-02")
+	}
 
 	// First, get a global total (without language filter) so user sees overall scale
-	globalQuery := fmt.Sprintf("topic:hacktoberfest stars:>=%d archived:false", minStars)
-	logger.Info(fmt.Sprintf("Getting global repository count with query: %s", globalQuery))
-	globalOpts := &github.SearchOptions{Sort: "stars", Order: "desc", ListOptions: github.ListOptions{PerPage: 1}}
-	globalResult, globalResp, globalErr := c.client.Search.Repositories(c.ctx, globalQuery, globalOpts)
-	totalAvailable := 0
-	if globalResp != nil {
-		logger.LogAPIRequest("repositories/search_total", globalQuery, globalResp.StatusCode, time.Since(start))
-		logger.Debug(fmt.Sprintf("(Total) Rate limit remaining: %d, resets at: %v", globalResp.Rate.Remaining, globalResp.Rate.Reset.Time))
-	}
-	if globalErr != nil {
-		logger.ErrorWithErr("Failed to retrieve global total repository count", globalErr)
-		// Continue with language searches even if global count fails
-	} else if globalResult != nil && globalResult.Total != nil {
-		totalAvailable = *globalResult.Total
-		logger.Info(fmt.Sprintf("Global Hacktoberfest repositories total: %d", totalAvailable))
-	} else {
-		logger.Info("Global count query succeeded but no total available")
+	stars := starsQualifier(minStars, opts.MaxStars)
+	totalAvailable := UnknownTotal
+	if mode != "label" {
+		globalQuery := fmt.Sprintf("topic:hacktoberfest%s%s", stars, qualifiers)
+		logger.Info(fmt.Sprintf("Getting global repository count with query: %s", globalQuery))
+		total, err := c.fetchGlobalTotal(ctx, globalQuery)
+		var invalid *InvalidQueryError
+		if errors.As(err, &invalid) {
+			return nil, 0, false, invalid
+		} else if errors.Is(err, ErrRateLimited) {
+			return nil, 0, false, err
+		} else if err != nil {
+			// Continue with language searches even if global count fails
+			logger.ErrorWithErr("Failed to retrieve global total repository count", err)
+		} else {
+			totalAvailable = total
+			logger.Info(fmt.Sprintf("Global Hacktoberfest repositories total: %d", totalAvailable))
+		}
 	}
 
-	for _, lang := range languages {
-		query := fmt.Sprintf("topic:hacktoberfest stars:>=%d archived:false sort:stars-desc", minStars)
+	var timeoutErr error
+	incomplete := false
+	for _, lang := range topicLanguages {
+		if ctx.Err() != nil {
+			return nil, 0, false, c.describeTimeout(ctx.Err(), ctx)
+		}
+
+		query := fmt.Sprintf("topic:hacktoberfest%s%s", stars, qualifiers)
 
 		// Add language filter if specified
 		if lang != "" {
@@ -109,28 +736,49 @@ func (c *Client) SearchHacktoberfestReposWithPage(minStars int, languages []stri
 
 		logger.Info(fmt.Sprintf("Repository search query: %s", query))
 
-		opts := &github.SearchOptions{
-			Sort:  "stars",
-			Order: "desc",
-			ListOptions: github.ListOptions{
-				Page:    page,
-				PerPage: min(100, maxResults), // GitHub API limit
-			},
-		}
+		searchOpts := repoSearchOptions(candidatesPerLanguage)
 
-		result, response, err := c.client.Search.Repositories(c.ctx, query, opts)
+		var result *github.RepositoriesSearchResult
+		err := c.withRateLimitRetry(ctx, "repository search", func() error {
+			reqCtx, cancel := c.requestContext(ctx)
+			defer cancel()
 
-		if response != nil {
-			logger.LogAPIRequest("repositories/search", query, response.StatusCode, time.Since(start))
-			logger.Debug(fmt.Sprintf("Rate limit remaining: %d, resets at: %v",
-				response.Rate.Remaining, response.Rate.Reset.Time))
+			var response *github.Response
+			var err error
+			result, response, err = c.client.Search.Repositories(reqCtx, query, searchOpts)
+			if response != nil {
+				logger.LogAPIRequest("repositories/search", query, response.StatusCode, time.Since(start))
+				logger.Debug(fmt.Sprintf("Rate limit remaining: %d, resets at: %v",
+					response.Rate.Remaining, response.Rate.Reset.Time))
+			}
+			return err
+		})
+
+		// An invalid query won't get better by trying other languages, and
+		// the user needs the validation details to fix their settings
+		if invalid := asInvalidQuery(err, query); invalid != nil {
+			logger.ErrorWithErr(fmt.Sprintf("GitHub rejected search query: %s", query), invalid)
+			return nil, 0, false, invalid
 		}
 
 		if err != nil {
+			err = c.describeTimeout(err, ctx)
 			logger.ErrorWithErr(fmt.Sprintf("Failed to search repositories for language: %s", lang), err)
+			// The other languages would hit the same limit
+			if ctx.Err() != nil || errors.Is(err, ErrRateLimited) {
+				return nil, 0, false, err
+			}
+			if errors.Is(err, context.DeadlineExceeded) {
+				timeoutErr = err
+			}
 			continue // Continue with other languages instead of failing completely
 		}
 
+		if result.GetIncompleteResults() {
+			incomplete = true
+			logger.Warn(fmt.Sprintf("GitHub search timed out and returned partial results for query: %s", query))
+		}
+
 		totalFound := 0
 		if result.Total != nil {
 			totalFound = *result.Total
@@ -144,9 +792,7 @@ func (c *Client) SearchHacktoberfestReposWithPage(minStars int, languages []stri
 			if repo.StargazersCount != nil && *repo.StargazersCount >= minStars {
 				repoKey := fmt.Sprintf("%s/%s", *repo.Owner.Login, *repo.Name)
 
-				// Skip archived repositories
-				if repo.Archived != nil && *repo.Archived {
-					logger.Debug(fmt.Sprintf("Repository %s is archived, skipping", repoKey))
+				if opts.skipRepository(repo, repoKey) {
 					continue
 				}
 
@@ -156,85 +802,90 @@ func (c *Client) SearchHacktoberfestReposWithPage(minStars int, languages []stri
 					continue
 				}
 
-				r := &Repository{
-					Repository: repo,
-				}
-				r.calculateRelevance(languages)
+				r := opts.newRepository(repo, repoKey)
+				r.calculateRelevance(opts.Languages, opts.Weights)
 				repoMap[repoKey] = r
 
 				logger.Debug(fmt.Sprintf("Repository processed: %s, stars: %d, archived: %v, relevance: %d",
 					repoKey, *repo.StargazersCount, repo.Archived != nil && *repo.Archived, r.RelevanceScore))
 			}
 		}
+	}
 
-		// Stop if we've reached our target
-		if len(repoMap) >= maxResults {
-			logger.Info(fmt.Sprintf("Reached maximum results (%d), stopping search", maxResults))
-			break
+	if mode != "topic" {
+		labelIncomplete, err := c.addLabelledRepos(ctx, opts, languages, repoMap)
+		if err != nil {
+			return nil, 0, false, err
 		}
+		incomplete = incomplete || labelIncomplete
 	}
 
-	// Convert map to slice
-	for _, repo := range repoMap {
-		allRepos = append(allRepos, repo)
+	if len(repoMap) == 0 && timeoutErr != nil {
+		return nil, 0, false, timeoutErr
 	}
 
-	// Sort by relevance score (highest first)
-	for i := 0; i < len(allRepos); i++ {
-		for j := i + 1; j < len(allRepos); j++ {
-			if allRepos[i].RelevanceScore < allRepos[j].RelevanceScore {
-				allRepos[i], allRepos[j] = allRepos[j], allRepos[i]
-			}
-		}
+	// Convert map to slice in name order, so even the input to the sort
+	// doesn't depend on map iteration order
+	keys := make([]string, 0, len(repoMap))
+	for key := range repoMap {
+		keys = append(keys, key)
 	}
-
-	// Limit to maxResults
-	if len(allRepos) > maxResults {
-		allRepos = allRepos[:maxResults]
+	sort.Strings(keys)
+	for _, key := range keys {
+		allRepos = append(allRepos, repoMap[key])
 	}
 
-	duration := time.Since(start)
-	logger.LogRepoSearch(fmt.Sprintf("languages: %v", languages), len(allRepos), len(allRepos), languages)
-	logger.Info(fmt.Sprintf("Repository search completed: %d returned (limit %d), global total: %d, took %v", len(allRepos), maxResults, totalAvailable, duration))
+	// Sort by relevance score (highest first), breaking ties by name. Names
+	// are unique after deduplication, so this is a total order and the
+	// result is identical across runs for identical search results.
+	rankCandidates(allRepos)
+
+	logger.Info(fmt.Sprintf("Repository candidates ranked: %d unique repos, took %v", len(allRepos), time.Since(start)))
 
-	return allRepos, totalAvailable, nil
+	return allRepos, totalAvailable, incomplete, nil
 }
 
-// GetRepositoryIssues fetches issues for a specific repository with label statistics
-func (c *Client) GetRepositoryIssues(owner, repo string, labels []string, maxResults int) (*IssueStats, error) {
+// GetRepositoryIssues fetches issues for a specific repository with label
+// statistics. With labels, only issues carrying them are fetched: all of them
+// in a single query, or any of them with one query per label when
+// filter.LabelMatch is "any".
+func (c *Client) GetRepositoryIssues(owner, repo string, labels []string, maxResults int, filter IssueFilter) (*IssueStats, error) {
 	start := time.Now()
 	repoName := fmt.Sprintf("%s/%s", owner, repo)
 
 	logger.Info(fmt.Sprintf("Starting issue search for %s", repoName))
 
+	resultKey := issuesCacheKey(owner, repo, labels, maxResults, filter)
+	if stats, ok := c.results.issueStats(resultKey); ok {
+		logCacheHit(fmt.Sprintf("issues of %s", repoName))
+		return stats, nil
+	}
+
+	field, direction := filter.issueOrder()
 	opts := &github.IssueListByRepoOptions{
 		State:     "open",
-		Sort:      "updated",
-		Direction: "desc",
+		Sort:      field,
+		Direction: direction,
 		ListOptions: github.ListOptions{
 			Page:    1,
 			PerPage: min(maxResults, 100),
 		},
 	}
 
-	logger.Debug(fmt.Sprintf("Making API call to list issues for %s with options: state=open, sort=updated, page=1, perPage=%d",
-		repoName, opts.ListOptions.PerPage))
-
-	issues, response, err := c.client.Issues.ListByRepo(c.ctx, owner, repo, opts)
-	duration := time.Since(start)
+	loadCtx, calls := countAPICalls(c.ctx)
 
-	if response != nil {
-		logger.LogAPIRequest("issues/list", repoName, response.StatusCode, duration)
-		logger.Debug(fmt.Sprintf("Rate limit remaining: %d, resets at: %v",
-			response.Rate.Remaining, response.Rate.Reset.Time))
-		logger.Debug(fmt.Sprintf("Response headers - Link: %s, Last-Modified: %s",
-			response.Header.Get("Link"), response.Header.Get("Last-Modified")))
+	var issues []*github.Issue
+	var err error
+	if len(labels) > 1 && filter.matchAnyLabel() {
+		issues, err = c.listIssuesWithAnyLabel(loadCtx, owner, repo, labels, opts)
+	} else {
+		opts.Labels = labels
+		issues, err = c.listIssues(loadCtx, owner, repo, opts)
 	}
-
 	if err != nil {
-		logger.ErrorWithErr("Failed to fetch repository issues", err)
 		return nil, fmt.Errorf("failed to fetch issues: %w", err)
 	}
+	duration := time.Since(start)
 
 	logger.Info(fmt.Sprintf("GitHub API returned %d items for %s (includes issues + PRs), took %v",
 		len(issues), repoName, duration))
@@ -242,6 +893,21 @@ func (c *Client) GetRepositoryIssues(owner, repo string, labels []string, maxRes
 	result := make([]*Issue, 0, len(issues))
 	labelCounts := make(map[string]int)
 	prCount := 0
+	excludedCount := 0
+	takenCount := 0
+	discussedCount := 0
+	commentedCount := 0
+	assignedCount := 0
+
+	var commented map[int]bool
+	if filter.ExcludeCommented || filter.MarkCommented {
+		commented = c.commentedIssues(loadCtx, owner, repo)
+	}
+
+	excluded := make(map[string]bool, len(filter.ExcludeLabels))
+	for _, label := range filter.ExcludeLabels {
+		excluded[strings.ToLower(label)] = true
+	}
 
 	logger.Debug(fmt.Sprintf("Processing %d items from GitHub API for %s", len(issues), repoName))
 
@@ -256,10 +922,48 @@ func (c *Client) GetRepositoryIssues(owner, repo string, labels []string, maxRes
 			continue
 		}
 
-		i := &Issue{
-			Issue: issue,
+		// Skip issues carrying an excluded label
+		if excludedLabel := findExcludedLabel(issue, excluded); excludedLabel != "" {
+			excludedCount++
+			logger.Debug(fmt.Sprintf("Skipping issue #%d: %s, excluded label: %s", *issue.Number, *issue.Title, excludedLabel))
+			continue
+		}
+
+		// Skip heavily discussed issues, which are rarely quick contributions
+		if filter.MaxComments > 0 && issue.GetComments() > filter.MaxComments {
+			discussedCount++
+			logger.Debug(fmt.Sprintf("Skipping issue #%d: %s, %d comments", *issue.Number, *issue.Title, issue.GetComments()))
+			continue
+		}
+
+		// Skip issues someone has claimed, before the costlier linked PR check
+		if filter.ExcludeAssigned && len(issue.Assignees) > 0 {
+			assignedCount++
+			logger.Debug(fmt.Sprintf("Skipping issue #%d: %s, assigned", *issue.Number, *issue.Title))
+			continue
 		}
-		i.calculateDifficulty()
+
+		// Skip issues someone is already working on
+		if filter.ExcludeWithOpenPRs {
+			events, err := c.timelineEvents(loadCtx, owner, repo, *issue.Number)
+			if err == nil && hasOpenLinkedPR(linkedPRs(events)) {
+				takenCount++
+				logger.Debug(fmt.Sprintf("Skipping issue #%d: %s, has an open linked PR", *issue.Number, *issue.Title))
+				continue
+			}
+		}
+
+		// Skip issues the user has already engaged with
+		if commented[issue.GetNumber()] && filter.ExcludeCommented {
+			commentedCount++
+			logger.Debug(fmt.Sprintf("Skipping issue #%d: %s, you commented on it", *issue.Number, *issue.Title))
+			continue
+		}
+
+		i := newIssue(issue)
+		i.Commented = commented[issue.GetNumber()]
+		c.trimBody(i)
+		c.scoreDifficulty(i)
 		result = append(result, i)
 
 		// Count all labels for statistics
@@ -275,26 +979,186 @@ func (c *Client) GetRepositoryIssues(owner, repo string, labels []string, maxRes
 			*issue.Number, *issue.Title, i.DifficultyScore, strings.Join(labelList, ", ")))
 	}
 
-	logger.Info(fmt.Sprintf("Processing complete for %s: %d total items, %d PRs skipped, %d actual issues, %d unique labels",
-		repoName, len(issues), prCount, len(result), len(labelCounts)))
+	logger.Info(fmt.Sprintf("Processing complete for %s: %d total items, %d PRs skipped, %d excluded, %d taken, %d too discussed, %d commented on, %d assigned, %d actual issues, %d unique labels, %d API calls",
+		repoName, len(issues), prCount, excludedCount, takenCount, discussedCount, commentedCount, assignedCount, len(result), len(labelCounts), calls.Load()))
 
 	stats := &IssueStats{
-		Issues:      result,
-		LabelCounts: labelCounts,
-		TotalIssues: len(result),
+		Issues:         result,
+		LabelCounts:    labelCounts,
+		TotalIssues:    len(result),
+		ExcludedCount:  excludedCount,
+		TakenCount:     takenCount,
+		DiscussedCount: discussedCount,
+		CommentedCount: commentedCount,
+		AssignedCount:  assignedCount,
+		APICalls:       int(calls.Load()),
 	}
 
 	logger.Info(fmt.Sprintf("Issue search completed for %s: returning %d issues with %d unique labels",
 		repoName, len(result), len(labelCounts)))
 
+	c.results.storeIssueStats(resultKey, stats)
 	return stats, nil
 }
 
+// listIssues fetches the first page of a repository's issues with opts
+func (c *Client) listIssues(parent context.Context, owner, repo string, opts *github.IssueListByRepoOptions) ([]*github.Issue, error) {
+	start := time.Now()
+	repoName := fmt.Sprintf("%s/%s", owner, repo)
+
+	logger.Debug(fmt.Sprintf("Making API call to list issues for %s with options: state=open, labels=%v, sort=%s, direction=%s, page=1, perPage=%d",
+		repoName, opts.Labels, opts.Sort, opts.Direction, opts.ListOptions.PerPage))
+
+	ctx, cancel := c.requestContext(parent)
+	defer cancel()
+
+	issues, response, err := c.client.Issues.ListByRepo(ctx, owner, repo, opts)
+	if response != nil {
+		logger.LogAPIRequest("issues/list", repoName, response.StatusCode, time.Since(start))
+		logger.Debug(fmt.Sprintf("Rate limit remaining: %d, resets at: %v",
+			response.Rate.Remaining, response.Rate.Reset.Time))
+		logger.Debug(fmt.Sprintf("Response headers - Link: %s, Last-Modified: %s",
+			response.Header.Get("Link"), response.Header.Get("Last-Modified")))
+	}
+
+	if err != nil {
+		err = c.describeTimeout(err, parent)
+		logger.ErrorWithErr("Failed to fetch repository issues", err)
+		return nil, err
+	}
+	return issues, nil
+}
+
+// listIssuesWithAnyLabel runs one issue query per label, since GitHub only
+// matches issues carrying every label of a query, and merges the results
+// without duplicates in the order a single query would have returned them
+func (c *Client) listIssuesWithAnyLabel(ctx context.Context, owner, repo string, labels []string, opts *github.IssueListByRepoOptions) ([]*github.Issue, error) {
+	var merged []*github.Issue
+	seen := make(map[int]bool)
+	for _, label := range labels {
+		labelOpts := *opts
+		labelOpts.Labels = []string{label}
+
+		issues, err := c.listIssues(ctx, owner, repo, &labelOpts)
+		if err != nil {
+			return nil, err
+		}
+		for _, issue := range issues {
+			if !seen[issue.GetNumber()] {
+				seen[issue.GetNumber()] = true
+				merged = append(merged, issue)
+			}
+		}
+	}
+
+	sortIssues(merged, opts.Sort, opts.Direction)
+	if len(merged) > opts.PerPage {
+		merged = merged[:opts.PerPage]
+	}
+
+	logger.Info(fmt.Sprintf("Merged %d distinct issues from %d label queries on %s/%s", len(merged), len(labels), owner, repo))
+	return merged, nil
+}
+
+// sortIssues orders issues the way the issues API does for field (created,
+// updated or comments) and direction (asc or desc), breaking ties by number
+func sortIssues(issues []*github.Issue, field, direction string) {
+	key := func(issue *github.Issue) int64 {
+		switch field {
+		case "created":
+			return issue.GetCreatedAt().Unix()
+		case "comments":
+			return int64(issue.GetComments())
+		}
+		return issue.GetUpdatedAt().Unix()
+	}
+
+	sort.SliceStable(issues, func(i, j int) bool {
+		a, b := key(issues[i]), key(issues[j])
+		if a == b {
+			return issues[i].GetNumber() > issues[j].GetNumber()
+		}
+		if direction == "asc" {
+			return a < b
+		}
+		return a > b
+	})
+}
+
+// findExcludedLabel returns the first label on the issue that is in the
+// excluded set, or an empty string if there is none
+func findExcludedLabel(issue *github.Issue, excluded map[string]bool) string {
+	if len(excluded) == 0 {
+		return ""
+	}
+
+	for _, label := range issue.Labels {
+		labelName := strings.ToLower(label.GetName())
+		if excluded[labelName] {
+			return labelName
+		}
+	}
+
+	return ""
+}
+
+// GetIssueDetail fetches the full details of a single issue. The list endpoint
+// is enough for browsing, so this is only called when the detail screen is
+// opened. Results are cached per issue.
+func (c *Client) GetIssueDetail(owner, repo string, number int) (*Issue, error) {
+	issueKey := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+
+	c.mu.Lock()
+	cached, ok := c.issueCache[issueKey]
+	c.mu.Unlock()
+	if ok {
+		logger.Debug(fmt.Sprintf("Issue detail cache hit for %s", issueKey))
+		return cached, nil
+	}
+
+	return c.fetchIssue(owner, repo, number)
+}
+
+// fetchIssue fetches a single issue from the API, bypassing and then
+// refreshing the issue detail cache
+func (c *Client) fetchIssue(owner, repo string, number int) (*Issue, error) {
+	issueKey := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+
+	start := time.Now()
+	ctx, cancel := c.requestContext(c.ctx)
+	defer cancel()
+
+	issue, response, err := c.client.Issues.Get(ctx, owner, repo, number)
+	if response != nil {
+		logger.LogAPIRequest("issues/get", issueKey, response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		err = c.describeTimeout(err, c.ctx)
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch issue detail for %s", issueKey), err)
+		return nil, fmt.Errorf("failed to fetch issue: %w", err)
+	}
+
+	result := newIssue(issue)
+	c.trimBody(result)
+	c.scoreDifficulty(result)
+
+	c.mu.Lock()
+	c.issueCache[issueKey] = result
+	c.mu.Unlock()
+
+	logger.Info(fmt.Sprintf("Issue detail fetched for %s, took %v", issueKey, time.Since(start)))
+
+	return result, nil
+}
+
 // GetRepositoryLanguages fetches the languages used in a repository
 func (c *Client) GetRepositoryLanguages(owner, repo string) ([]string, error) {
-	languages, _, err := c.client.Repositories.ListLanguages(c.ctx, owner, repo)
+	ctx, cancel := c.requestContext(c.ctx)
+	defer cancel()
+
+	languages, _, err := c.client.Repositories.ListLanguages(ctx, owner, repo)
 	if err != nil {
-		return nil, err
+		return nil, c.describeTimeout(err, c.ctx)
 	}
 
 	result := make([]string, 0, len(languages))
@@ -305,35 +1169,195 @@ func (c *Client) GetRepositoryLanguages(owner, repo string) ([]string, error) {
 	return result, nil
 }
 
-// calculateRelevance calculates a relevance score based on preferred languages
-func (r *Repository) calculateRelevance(preferredLanguages []string) {
-	score := 0
+// ErrNoReadme is returned by GetRepositoryReadme when the repository has no
+// README
+var ErrNoReadme = errors.New("repository has no README")
+
+// GetRepositoryReadme fetches and decodes a repository's README, returning at
+// most maxLines lines. Results are cached per repository.
+func (c *Client) GetRepositoryReadme(owner, repo string, maxLines int) (string, error) {
+	repoName := fmt.Sprintf("%s/%s", owner, repo)
 
-	// Base score from stars (logarithmic scale)
+	c.mu.Lock()
+	cached, ok := c.readmeCache[repoName]
+	c.mu.Unlock()
+	if ok {
+		logger.Debug(fmt.Sprintf("README cache hit for %s", repoName))
+		return cached, nil
+	}
+
+	start := time.Now()
+	ctx, cancel := c.requestContext(c.ctx)
+	defer cancel()
+
+	readme, response, err := c.client.Repositories.GetReadme(ctx, owner, repo, nil)
+	if response != nil {
+		logger.LogAPIRequest("repos/readme", repoName, response.StatusCode, time.Since(start))
+	}
+	if response != nil && response.StatusCode == http.StatusNotFound {
+		logger.Info(fmt.Sprintf("No README found for %s", repoName))
+		return "", ErrNoReadme
+	}
+	if err != nil {
+		err = c.describeTimeout(err, c.ctx)
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch README for %s", repoName), err)
+		return "", fmt.Errorf("failed to fetch README: %w", err)
+	}
+
+	content, err := readme.GetContent()
+	if err != nil {
+		logger.ErrorWithErr(fmt.Sprintf("Failed to decode README for %s", repoName), err)
+		return "", fmt.Errorf("failed to decode README: %w", err)
+	}
+
+	lines := strings.Split(strings.ReplaceAll(content, "\r\n", "\n"), "\n")
+	if maxLines > 0 && len(lines) > maxLines {
+		lines = append(lines[:maxLines], "...")
+	}
+	excerpt := strings.Join(lines, "\n")
+
+	c.mu.Lock()
+	c.readmeCache[repoName] = excerpt
+	c.mu.Unlock()
+
+	logger.Info(fmt.Sprintf("README fetched for %s: %d bytes, took %v", repoName, len(content), time.Since(start)))
+
+	return excerpt, nil
+}
+
+// Rescore recalculates the relevance score with new weights, e.g. while the
+// weights are being tuned
+func (r *Repository) Rescore(preferredLanguages []string, weights RelevanceWeights) {
+	r.calculateRelevance(preferredLanguages, weights.validated())
+}
+
+// RankRepositories re-sorts repositories after rescoring. Pinned repositories
+// stay on top in their pinned order, the rest go by relevance.
+func RankRepositories(repos []*Repository) {
+	sort.SliceStable(repos, func(i, j int) bool {
+		a, b := repos[i], repos[j]
+		if a.Pinned || b.Pinned {
+			return a.Pinned && !b.Pinned
+		}
+		return a.rankedBefore(b)
+	})
+}
+
+// rankCandidates sorts freshly searched repositories with rankedBefore
+func rankCandidates(repos []*Repository) {
+	sort.SliceStable(repos, func(i, j int) bool {
+		return repos[i].rankedBefore(repos[j])
+	})
+}
+
+// rankedBefore reports whether r should be listed before other: higher
+// relevance first, then alphabetically by owner/name. Every ranking sorts
+// with it, so equal scores never fall back to an arbitrary order.
+func (r *Repository) rankedBefore(other *Repository) bool {
+	if r.RelevanceScore != other.RelevanceScore {
+		return r.RelevanceScore > other.RelevanceScore
+	}
+	return repoFullName(r.Repository) < repoFullName(other.Repository)
+}
+
+// repoFullName returns "owner/name" for a repository
+func repoFullName(repo *github.Repository) string {
+	return fmt.Sprintf("%s/%s", repo.GetOwner().GetLogin(), repo.GetName())
+}
+
+// calculateRelevance calculates a relevance score based on preferred languages,
+// recent activity and the star count, scored according to weights
+func (r *Repository) calculateRelevance(preferredLanguages []string, weights RelevanceWeights) {
+	var factors []RelevanceFactor
+
+	// Base score from stars, linear up to the configured cap
 	if r.Repository.StargazersCount != nil {
 		stars := *r.Repository.StargazersCount
 		if stars > 0 {
-			score += min(100, stars/10)
+			factors = append(factors, RelevanceFactor{
+				Reason: fmt.Sprintf("%s stars", FormatCount(stars)),
+				Points: weights.Stars.points(stars),
+			})
 		}
 	}
 
 	// Recent activity bonus
-	if r.Repository.UpdatedAt != nil && r.Repository.UpdatedAt.After(time.Now().AddDate(0, -1, 0)) {
-		score += 20
+	if weights.RecentBonus > 0 && r.Repository.UpdatedAt != nil && r.Repository.UpdatedAt.After(time.Now().AddDate(0, -1, 0)) {
+		factors = append(factors, RelevanceFactor{Reason: "recently updated", Points: weights.RecentBonus})
+	}
+
+	// Organization bonus, since org-backed projects tend to review PRs
+	if weights.OrgBonus > 0 && r.Repository.GetOwner().GetType() == "Organization" {
+		factors = append(factors, RelevanceFactor{Reason: "owned by an organization", Points: weights.OrgBonus})
+	}
+
+	// Dependency bonus, since fixes upstream also help the user's own projects
+	if weights.DependencyBonus > 0 && r.Dependency {
+		factors = append(factors, RelevanceFactor{Reason: "you depend on this", Points: weights.DependencyBonus})
+	}
+
+	// Familiar territory bonus, since the user already knows the project
+	if weights.FamiliarBonus > 0 && (r.Starred || r.Contributed) {
+		reason := "you starred this"
+		if r.Contributed {
+			reason = "you already contribute here"
+		}
+		factors = append(factors, RelevanceFactor{Reason: reason, Points: weights.FamiliarBonus})
 	}
 
-	// Language preference bonus
-	if r.Repository.Language != nil {
+	// Language preference bonus, graded by position in the preference list so
+	// the first language outranks the second on otherwise equal repositories
+	if weights.LanguageBonus > 0 && r.Repository.Language != nil {
 		repoLang := strings.ToLower(*r.Repository.Language)
-		for _, prefLang := range preferredLanguages {
+		for i, prefLang := range preferredLanguages {
 			if strings.ToLower(prefLang) == repoLang {
-				score += 50
+				factors = append(factors, RelevanceFactor{
+					Reason: fmt.Sprintf("matches your preferred language %s", *r.Repository.Language),
+					Points: languageBonus(i, weights.LanguageBonus),
+				})
 				break
 			}
 		}
 	}
 
+	score := 0
+	for _, factor := range factors {
+		score += factor.Points
+	}
+
 	r.RelevanceScore = score
+	r.RelevanceFactors = factors
+}
+
+// ExplainRelevance describes why the repository scored what it did, e.g.
+// "matches your preferred language Go (+50), recently updated (+20)"
+func (r *Repository) ExplainRelevance() string {
+	if len(r.RelevanceFactors) == 0 {
+		return "no relevance bonuses apply"
+	}
+
+	parts := make([]string, 0, len(r.RelevanceFactors))
+	for _, factor := range r.RelevanceFactors {
+		parts = append(parts, fmt.Sprintf("%s (+%d)", factor.Reason, factor.Points))
+	}
+	return strings.Join(parts, ", ")
+}
+
+// FormatCount abbreviates large counts, e.g. 1234 becomes "1.2k"
+func FormatCount(n int) string {
+	switch {
+	case n >= 1_000_000:
+		return fmt.Sprintf("%.1fM", float64(n)/1_000_000)
+	case n >= 1_000:
+		return fmt.Sprintf("%.1fk", float64(n)/1_000)
+	}
+	return fmt.Sprintf("%d", n)
+}
+
+// languageBonus returns the relevance bonus for a match at the given index of
+// the preferred languages list, where the first language earns top points
+func languageBonus(index, top int) int {
+	return max(min(minLanguageBonus, top), top-index*languageBonusStep)
 }
 
 // calculateDifficulty estimates issue difficulty based on labels and content
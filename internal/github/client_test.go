@@ -0,0 +1,521 @@
+package github
+
+import (
+	"context"
+	"encoding/json"
+	"errors"
+	"fmt"
+	"math/rand"
+	"net/http"
+	"net/http/httptest"
+	"net/url"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// roundTripFunc lets a function stand in for the GitHub API
+type roundTripFunc func(*http.Request) *http.Response
+
+func (f roundTripFunc) RoundTrip(req *http.Request) (*http.Response, error) {
+	return f(req), nil
+}
+
+// newTestClient returns a client whose API requests are answered by rt
+func newTestClient(rt roundTripFunc) *Client {
+	c := NewClient("")
+	c.client = github.NewClient(&http.Client{Transport: rt})
+	return c
+}
+
+// searchResponse answers every repository search with the given repositories
+// and records the URL parameters of each request
+func searchResponse(t *testing.T, repos []*github.Repository, requests *[]url.Values) roundTripFunc {
+	return func(req *http.Request) *http.Response {
+		*requests = append(*requests, req.URL.Query())
+
+		body, err := json.Marshal(github.RepositoriesSearchResult{
+			Total:        github.Int(len(repos)),
+			Repositories: repos,
+		})
+		if err != nil {
+			t.Fatalf("failed to encode search response: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteHeader(http.StatusOK)
+		rec.Write(body)
+		return rec.Result()
+	}
+}
+
+func testRepo(name string, archived bool) *github.Repository {
+	return &github.Repository{
+		Owner:           &github.User{Login: github.String("octo")},
+		Name:            github.String(name),
+		StargazersCount: github.Int(100),
+		Archived:        github.Bool(archived),
+	}
+}
+
+func repoNames(repos []*Repository) []string {
+	names := make([]string, len(repos))
+	for i, repo := range repos {
+		names[i] = repo.GetName()
+	}
+	return names
+}
+
+func TestSearchExcludesArchivedRepositories(t *testing.T) {
+	var requests []url.Values
+	c := newTestClient(searchResponse(t, []*github.Repository{
+		testRepo("active", false),
+		testRepo("archived", true),
+	}, &requests))
+
+	result, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{MinStars: 10}, 10, 1)
+	if err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+
+	if names := repoNames(result.Repositories); len(names) != 1 || names[0] != "active" {
+		t.Errorf("repositories = %v, want only [active]", names)
+	}
+	for _, params := range requests {
+		if query := params.Get("q"); !strings.Contains(query, "archived:false") {
+			t.Errorf("query %q does not exclude archived repositories", query)
+		}
+	}
+}
+
+func TestSearchIncludeArchivedKeepsArchivedRepositories(t *testing.T) {
+	var requests []url.Values
+	c := newTestClient(searchResponse(t, []*github.Repository{
+		testRepo("active", false),
+		testRepo("archived", true),
+	}, &requests))
+
+	opts := RepoSearchOptions{MinStars: 10, IncludeArchived: true}
+	result, err := c.SearchHacktoberfestReposWithPage(opts, 10, 1)
+	if err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+
+	names := repoNames(result.Repositories)
+	if len(names) != 2 {
+		t.Fatalf("repositories = %v, want both active and archived", names)
+	}
+	for _, params := range requests {
+		if query := params.Get("q"); strings.Contains(query, "archived:") {
+			t.Errorf("query %q filters on archived although IncludeArchived is set", query)
+		}
+	}
+}
+
+func TestStarsQualifier(t *testing.T) {
+	tests := []struct {
+		minStars, maxStars int
+		want               string
+	}{
+		{20, 500, " stars:20..500"},
+		{20, 0, " stars:>=20"},
+		{0, 500, " stars:<=500"},
+		{0, 0, ""},
+	}
+	for _, tt := range tests {
+		if got := starsQualifier(tt.minStars, tt.maxStars); got != tt.want {
+			t.Errorf("starsQualifier(%d, %d) = %q, want %q", tt.minStars, tt.maxStars, got, tt.want)
+		}
+	}
+}
+
+func TestSearchSpecifiesSortOnlyInOptions(t *testing.T) {
+	var requests []url.Values
+	c := newTestClient(searchResponse(t, []*github.Repository{testRepo("active", false)}, &requests))
+
+	opts := RepoSearchOptions{MinStars: 10, Languages: []string{"Go", "Rust"}}
+	if _, err := c.SearchHacktoberfestReposWithPage(opts, 10, 1); err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+
+	if len(requests) == 0 {
+		t.Fatal("no search requests were sent")
+	}
+	for _, params := range requests {
+		if query := params.Get("q"); strings.Contains(query, "sort:") {
+			t.Errorf("query %q sorts in the query string as well as the options", query)
+		}
+		if sort, order := params.Get("sort"), params.Get("order"); sort != "stars" || order != "desc" {
+			t.Errorf("query %q sent sort=%q order=%q, want sort=stars order=desc", params.Get("q"), sort, order)
+		}
+	}
+}
+
+func TestTrimBodyCutsOnRuneBoundary(t *testing.T) {
+	c := NewClient("")
+	c.SetMaxIssueBodyBytes(5)
+
+	issue := &Issue{Issue: &github.Issue{Body: github.String("abcdé fgh")}} // é is two bytes, at 4-5
+	c.trimBody(issue)
+	if got := issue.GetBody(); got != "abcd" {
+		t.Errorf("trimmed body = %q, want %q", got, "abcd")
+	}
+	if !issue.BodyTruncated {
+		t.Error("BodyTruncated = false after trimming, want true")
+	}
+
+	short := &Issue{Issue: &github.Issue{Body: github.String("abc")}}
+	c.trimBody(short)
+	if short.GetBody() != "abc" || short.BodyTruncated {
+		t.Errorf("short body = %q, truncated %v, want it kept whole", short.GetBody(), short.BodyTruncated)
+	}
+}
+
+func TestGlobalTotalRetriesTransientFailures(t *testing.T) {
+	var requests []url.Values
+	succeed := searchResponse(t, []*github.Repository{testRepo("one", false)}, &requests)
+	failures := 0
+	failFirst := func(n int) roundTripFunc {
+		return func(req *http.Request) *http.Response {
+			if failures < n {
+				failures++
+				rec := httptest.NewRecorder()
+				rec.WriteHeader(http.StatusBadGateway)
+				return rec.Result()
+			}
+			return succeed(req)
+		}
+	}
+
+	c := newTestClient(failFirst(1))
+	c.SetTotalCountRetries(2)
+	total, err := c.fetchGlobalTotal(context.Background(), "topic:hacktoberfest")
+	if err != nil || total != 1 {
+		t.Errorf("fetchGlobalTotal after one failure = %d, %v, want 1, nil", total, err)
+	}
+
+	failures = 0
+	c = newTestClient(failFirst(3))
+	c.SetTotalCountRetries(2)
+	result, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{}, 10, 1)
+	if err != nil {
+		t.Fatalf("search with a failing count query: %v", err)
+	}
+	if result.TotalAvailable != UnknownTotal {
+		t.Errorf("TotalAvailable after the count query kept failing = %d, want UnknownTotal", result.TotalAvailable)
+	}
+}
+
+func TestSearchWaitsOutRateLimits(t *testing.T) {
+	var requests []url.Values
+	succeed := searchResponse(t, []*github.Repository{testRepo("one", false)}, &requests)
+	limited := 0
+	limitFirst := func(n int) roundTripFunc {
+		return func(req *http.Request) *http.Response {
+			if limited < n {
+				limited++
+				rec := httptest.NewRecorder()
+				rec.Header().Set("Retry-After", "0")
+				rec.WriteHeader(http.StatusTooManyRequests)
+				return rec.Result()
+			}
+			return succeed(req)
+		}
+	}
+
+	c := newTestClient(limitFirst(2))
+	c.SetRateLimitRetries(3, time.Minute)
+	result, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{}, 10, 1)
+	if err != nil {
+		t.Fatalf("search after two rate limited responses: %v", err)
+	}
+	if names := repoNames(result.Repositories); len(names) != 1 || names[0] != "one" {
+		t.Errorf("repositories = %v, want [one]", names)
+	}
+
+	limited = 0
+	c = newTestClient(limitFirst(10))
+	c.SetRateLimitRetries(1, time.Minute)
+	if _, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{}, 10, 1); !errors.Is(err, ErrRateLimited) {
+		t.Errorf("search that stays rate limited returned %v, want ErrRateLimited", err)
+	}
+}
+
+func TestResultCacheReusesSearchesUntilExpired(t *testing.T) {
+	var requests []url.Values
+	c := newTestClient(searchResponse(t, []*github.Repository{testRepo("active", false)}, &requests))
+	c.results = newResultCache(time.Minute)
+
+	goRepos := RepoSearchOptions{MinStars: 10, Languages: []string{"Go"}}
+	rustRepos := RepoSearchOptions{MinStars: 10, Languages: []string{"Rust"}}
+	for _, opts := range []RepoSearchOptions{goRepos, rustRepos} {
+		if _, err := c.SearchHacktoberfestReposWithPage(opts, 10, 1); err != nil {
+			t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+		}
+	}
+	sent := len(requests)
+
+	// Switching back replaces the ranked candidates, but the page is cached
+	result, err := c.SearchHacktoberfestReposWithPage(goRepos, 10, 1)
+	if err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+	if len(requests) != sent {
+		t.Errorf("cached search sent %d requests, want none", len(requests)-sent)
+	}
+	if names := repoNames(result.Repositories); len(names) != 1 || names[0] != "active" || result.APICalls != 0 {
+		t.Errorf("cached result = %v with %d API calls, want [active] with none", names, result.APICalls)
+	}
+
+	for key, entry := range c.results.searches {
+		entry.expires = time.Now().Add(-time.Second)
+		c.results.searches[key] = entry
+	}
+	if _, err := c.SearchHacktoberfestReposWithPage(goRepos, 10, 1); err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+	if len(requests) == sent {
+		t.Error("expired search was served from the cache")
+	}
+}
+
+func TestClearRepositoryIssuesCacheDropsIssueDetails(t *testing.T) {
+	c := NewClient("")
+	c.issueCache["octo/repo#1"] = &Issue{}
+	c.issueCache["octo/repo-two#1"] = &Issue{}
+
+	c.ClearRepositoryIssuesCache("octo", "repo")
+	if _, ok := c.issueCache["octo/repo#1"]; ok {
+		t.Error("issue detail of the cleared repository is still cached")
+	}
+	if _, ok := c.issueCache["octo/repo-two#1"]; !ok {
+		t.Error("issue detail of another repository was dropped")
+	}
+}
+
+func TestSearchReportsIncompleteResults(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		body, err := json.Marshal(github.RepositoriesSearchResult{
+			Total:             github.Int(1),
+			IncompleteResults: github.Bool(true),
+			Repositories:      []*github.Repository{testRepo("one", false)},
+		})
+		if err != nil {
+			t.Fatalf("failed to encode search response: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteHeader(http.StatusOK)
+		rec.Write(body)
+		return rec.Result()
+	})
+
+	result, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{}, 10, 1)
+	if err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+	if !result.Incomplete {
+		t.Error("Incomplete = false for a search GitHub returned partial results for")
+	}
+}
+
+func TestGetRepositoryIssuesSkipsIssuesYouCommentedOn(t *testing.T) {
+	issues := []*github.Issue{
+		{Number: github.Int(1), Title: github.String("fresh")},
+		{Number: github.Int(2), Title: github.String("discussed")},
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		var body []byte
+		var err error
+		if strings.HasPrefix(req.URL.Path, "/search/issues") {
+			body, err = json.Marshal(github.IssuesSearchResult{
+				Total:  github.Int(1),
+				Issues: []*github.Issue{issues[1]},
+			})
+		} else {
+			body, err = json.Marshal(issues)
+		}
+		if err != nil {
+			t.Fatalf("failed to encode response: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteHeader(http.StatusOK)
+		rec.Write(body)
+		return rec.Result()
+	})
+
+	stats, err := c.GetRepositoryIssues("octo", "repo", nil, 10, IssueFilter{ExcludeCommented: true, MarkCommented: true})
+	if err != nil {
+		t.Fatalf("GetRepositoryIssues returned error: %v", err)
+	}
+	if len(stats.Issues) != 1 || stats.Issues[0].GetNumber() != 1 || stats.CommentedCount != 1 {
+		t.Errorf("got %d issues with %d commented on, want only #1 with 1 commented on", len(stats.Issues), stats.CommentedCount)
+	}
+
+	stats, err = c.GetRepositoryIssues("octo", "repo", nil, 10, IssueFilter{MarkCommented: true})
+	if err != nil {
+		t.Fatalf("GetRepositoryIssues returned error: %v", err)
+	}
+	if len(stats.Issues) != 2 || stats.Issues[0].Commented || !stats.Issues[1].Commented {
+		t.Errorf("without ExcludeCommented, want both issues with only #2 marked commented")
+	}
+}
+
+func TestLabelDiscoveryFindsReposWithoutTheTopic(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		var body []byte
+		var err error
+		switch req.URL.Path {
+		case "/search/issues":
+			issue := func(repo string) *github.Issue {
+				return &github.Issue{RepositoryURL: github.String("https://api.github.com/repos/octo/" + repo)}
+			}
+			body, err = json.Marshal(github.IssuesSearchResult{
+				Total:  github.Int(3),
+				Issues: []*github.Issue{issue("labelled"), issue("labelled"), issue("archived")},
+			})
+		case "/repos/octo/labelled":
+			body, err = json.Marshal(testRepo("labelled", false))
+		case "/repos/octo/archived":
+			body, err = json.Marshal(testRepo("archived", true))
+		default:
+			t.Errorf("unexpected request to %s", req.URL.Path)
+		}
+		if err != nil {
+			t.Fatalf("failed to encode response: %v", err)
+		}
+
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteHeader(http.StatusOK)
+		rec.Write(body)
+		return rec.Result()
+	})
+
+	result, err := c.SearchHacktoberfestReposWithPage(RepoSearchOptions{DiscoveryMode: "label"}, 10, 1)
+	if err != nil {
+		t.Fatalf("SearchHacktoberfestReposWithPage returned error: %v", err)
+	}
+	if got := repoNames(result.Repositories); len(got) != 1 || got[0] != "labelled" {
+		t.Errorf("label discovery found %v, want only [labelled]", got)
+	}
+	if result.TotalAvailable != UnknownTotal {
+		t.Errorf("TotalAvailable = %d, want UnknownTotal without a topic search", result.TotalAvailable)
+	}
+}
+
+// rankByNestedLoop is the quadratic pairwise sort rankCandidates replaced,
+// kept to check both give the same order
+func rankByNestedLoop(repos []*Repository) {
+	for i := 0; i < len(repos); i++ {
+		for j := i + 1; j < len(repos); j++ {
+			if repos[j].rankedBefore(repos[i]) {
+				repos[i], repos[j] = repos[j], repos[i]
+			}
+		}
+	}
+}
+
+func TestRankCandidatesMatchesNestedLoopSort(t *testing.T) {
+	rng := rand.New(rand.NewSource(1))
+	for _, size := range []int{0, 1, 2, 17, 501, 1000} {
+		nested := make([]*Repository, size)
+		for i := range nested {
+			nested[i] = &Repository{
+				Repository:     testRepo(fmt.Sprintf("repo-%d", rng.Intn(1_000_000_000)), false),
+				RelevanceScore: rng.Intn(20), // plenty of ties broken by name
+			}
+			nested[i].Name = github.String(fmt.Sprintf("%s-%d", nested[i].GetName(), i)) // names stay unique
+		}
+		ranked := append([]*Repository(nil), nested...)
+
+		rankByNestedLoop(nested)
+		rankCandidates(ranked)
+
+		for i := range nested {
+			if nested[i] != ranked[i] {
+				t.Fatalf("size %d: position %d is %s after the nested-loop sort, %s after rankCandidates",
+					size, i, nested[i].GetName(), ranked[i].GetName())
+			}
+		}
+	}
+}
+
+func TestGetRepositoryIssuesMarksAndHidesAssignedIssues(t *testing.T) {
+	issues := []*github.Issue{
+		{Number: github.Int(1), Title: github.String("open"), Labels: []*github.Label{{Name: github.String("docs")}}},
+		{
+			Number:    github.Int(2),
+			Title:     github.String("claimed"),
+			Labels:    []*github.Label{{Name: github.String("bug")}},
+			Assignees: []*github.User{{Login: github.String("a")}, {Login: github.String("b")}},
+		},
+	}
+	c := newTestClient(func(req *http.Request) *http.Response {
+		body, err := json.Marshal(issues)
+		if err != nil {
+			t.Fatalf("failed to encode response: %v", err)
+		}
+		rec := httptest.NewRecorder()
+		rec.Header().Set("Content-Type", "application/json")
+		rec.WriteHeader(http.StatusOK)
+		rec.Write(body)
+		return rec.Result()
+	})
+
+	stats, err := c.GetRepositoryIssues("octo", "repo", nil, 10, IssueFilter{})
+	if err != nil {
+		t.Fatalf("GetRepositoryIssues returned error: %v", err)
+	}
+	if len(stats.Issues) != 2 || stats.Issues[0].Assigned || !stats.Issues[1].Assigned || stats.Issues[1].AssigneeCount != 2 {
+		t.Errorf("want both issues with only #2 marked assigned to 2 people")
+	}
+
+	stats, err = c.GetRepositoryIssues("octo", "repo", nil, 10, IssueFilter{ExcludeAssigned: true})
+	if err != nil {
+		t.Fatalf("GetRepositoryIssues returned error: %v", err)
+	}
+	if len(stats.Issues) != 1 || stats.AssignedCount != 1 || stats.LabelCounts["bug"] != 0 || stats.LabelCounts["docs"] != 1 {
+		t.Errorf("got %d issues, %d assigned, labels %v; want only #1 and its labels", len(stats.Issues), stats.AssignedCount, stats.LabelCounts)
+	}
+}
+
+func TestProjectCardsListsAReAddedBoardOnce(t *testing.T) {
+	c := newTestClient(func(req *http.Request) *http.Response {
+		id := strings.TrimPrefix(req.URL.Path, "/projects/")
+		rec := httptest.NewRecorder()
+		fmt.Fprintf(rec, `{"id": %s, "name": "Board %s"}`, id, id)
+		return rec.Result()
+	})
+	event := func(kind string, project int64, column string) *github.Timeline {
+		return &github.Timeline{
+			Event:       github.String(kind),
+			ProjectCard: &github.ProjectCard{ProjectID: github.Int64(project), ColumnName: github.String(column)},
+		}
+	}
+
+	cards := c.projectCards([]*github.Timeline{
+		event("added_to_project", 1, "To do"),
+		event("added_to_project", 2, "Backlog"),
+		event("removed_from_project", 1, ""),
+		event("added_to_project", 1, "In progress"),
+	})
+	want := []ProjectCard{
+		{ProjectID: 1, Project: "Board 1", Column: "In progress"},
+		{ProjectID: 2, Project: "Board 2", Column: "Backlog"},
+	}
+	if len(cards) != len(want) {
+		t.Fatalf("projectCards = %+v, want %+v", cards, want)
+	}
+	for i := range want {
+		if cards[i] != want[i] {
+			t.Errorf("card %d = %+v, want %+v", i, cards[i], want[i])
+		}
+	}
+}
@@ -0,0 +1,46 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// commentedPages bounds how many pages of 100 issues are read when looking
+// up the issues of a repository the user has commented on
+const commentedPages = 3
+
+// commentedIssues returns the numbers of the open issues of owner/repo the
+// authenticated user has commented on, using the commenter:@me search
+// qualifier. Failures are logged and leave the set partial.
+func (c *Client) commentedIssues(ctx context.Context, owner, repo string) map[int]bool {
+	commented := make(map[int]bool)
+	query := fmt.Sprintf("repo:%s/%s is:issue is:open commenter:@me", owner, repo)
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 1; page <= commentedPages; page++ {
+		opts.Page = page
+		reqCtx, cancel := c.requestContext(ctx)
+		start := time.Now()
+		result, response, err := c.client.Search.Issues(reqCtx, query, opts)
+		cancel()
+		if response != nil {
+			logger.LogAPIRequest("search/issues", query, response.StatusCode, time.Since(start))
+		}
+		if err != nil {
+			logger.ErrorWithErr("Failed to search issues you commented on", c.describeTimeout(err, ctx))
+			break
+		}
+
+		for _, issue := range result.Issues {
+			commented[issue.GetNumber()] = true
+		}
+		if response.NextPage == 0 {
+			break
+		}
+	}
+	return commented
+}
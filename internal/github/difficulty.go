@@ -0,0 +1,112 @@
+package github
+
+import (
+	"fmt"
+	"regexp"
+	"sort"
+	"strings"
+
+	"hacktober/internal/logger"
+)
+
+// UnknownDifficulty is the difficulty score of an issue with no labels and no
+// comments to judge it by, when SetUnknownDifficulty is on
+const UnknownDifficulty = -1
+
+// DifficultyBand returns the difficulty band of a score: Easy, Medium, Hard,
+// Expert or Unknown
+func DifficultyBand(score int) string {
+	switch {
+	case score == UnknownDifficulty:
+		return "Unknown"
+	case score <= 30:
+		return "Easy"
+	case score <= 60:
+		return "Medium"
+	case score <= 80:
+		return "Hard"
+	default:
+		return "Expert"
+	}
+}
+
+// difficultyLabelRule gives issues carrying a matching label a fixed
+// difficulty score
+type difficultyLabelRule struct {
+	name    string         // lowercased exact label name, when pattern is nil
+	pattern *regexp.Regexp // label pattern, for rules written as /regex/
+	score   int
+}
+
+// matches reports whether a lowercased label name matches the rule
+func (r difficultyLabelRule) matches(label string) bool {
+	if r.pattern != nil {
+		return r.pattern.MatchString(label)
+	}
+	return r.name == label
+}
+
+// SetDifficultyLabels configures structured difficulty labels that override
+// the keyword heuristics. Keys are exact label names (case-insensitive) or
+// regular expressions wrapped in slashes, e.g. "/^effort: [12]$/"; values are
+// difficulty scores from 0 to 100. Invalid patterns are skipped with a warning.
+func (c *Client) SetDifficultyLabels(labels map[string]int) {
+	keys := make([]string, 0, len(labels))
+	for key := range labels {
+		keys = append(keys, key)
+	}
+	sort.Strings(keys) // deterministic precedence between overlapping rules
+
+	rules := make([]difficultyLabelRule, 0, len(keys))
+	for _, key := range keys {
+		rule := difficultyLabelRule{score: max(0, min(100, labels[key]))}
+		if len(key) > 2 && strings.HasPrefix(key, "/") && strings.HasSuffix(key, "/") {
+			pattern, err := regexp.Compile("(?i)" + key[1:len(key)-1])
+			if err != nil {
+				logger.Warn(fmt.Sprintf("Ignoring invalid difficulty label pattern %s: %v", key, err))
+				continue
+			}
+			rule.pattern = pattern
+		} else {
+			rule.name = strings.ToLower(key)
+		}
+		rules = append(rules, rule)
+	}
+
+	c.mu.Lock()
+	c.difficultyRules = rules
+	c.mu.Unlock()
+}
+
+// SetUnknownDifficulty makes issues without labels or comments score
+// UnknownDifficulty rather than the heuristics' default of medium
+func (c *Client) SetUnknownDifficulty(enabled bool) {
+	c.mu.Lock()
+	c.unknownDifficulty = enabled
+	c.mu.Unlock()
+}
+
+// scoreDifficulty sets an issue's difficulty from the configured difficulty
+// labels, falling back to the keyword heuristics
+func (c *Client) scoreDifficulty(issue *Issue) {
+	c.mu.Lock()
+	rules, unknown := c.difficultyRules, c.unknownDifficulty
+	c.mu.Unlock()
+
+	if unknown && len(issue.Issue.Labels) == 0 && issue.Issue.GetComments() == 0 {
+		issue.DifficultyScore = UnknownDifficulty
+		return
+	}
+
+	for _, label := range issue.Issue.Labels {
+		name := strings.ToLower(label.GetName())
+		for _, rule := range rules {
+			if rule.matches(name) {
+				issue.DifficultyScore = rule.score
+				return
+			}
+		}
+	}
+
+	issue.calculateDifficulty()
+}
@@ -0,0 +1,176 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// labelDiscoveryRepos bounds how many repositories found through labelled
+// issues are fetched per search, since each costs one API call
+const labelDiscoveryRepos = 30
+
+// discoveryModes are the accepted RepoSearchOptions.DiscoveryMode values
+var discoveryModes = map[string]bool{"topic": true, "label": true, "both": true}
+
+// discoveryMode returns how repositories are discovered: "topic" searches
+// for the hacktoberfest topic, "label" for repositories with open issues
+// labelled hacktoberfest, and "both" merges the two. Unset or unknown modes
+// fall back to "topic".
+func (o RepoSearchOptions) discoveryMode() string {
+	mode := strings.ToLower(o.DiscoveryMode)
+	if !discoveryModes[mode] {
+		if mode != "" {
+			logger.Warn(fmt.Sprintf("Ignoring unknown discovery mode %q, searching by topic", o.DiscoveryMode))
+		}
+		return "topic"
+	}
+	return mode
+}
+
+// addLabelledRepos searches open issues labelled hacktoberfest in each of
+// languages, or in every language when that is empty, and adds the
+// repositories they belong to that repoMap doesn't hold yet, most labelled
+// issues first. It reports whether GitHub returned partial results. A failed
+// language search is skipped, but an invalid query, a rate limit or an
+// expired ctx stop the search with an error.
+func (c *Client) addLabelledRepos(ctx context.Context, opts RepoSearchOptions, languages []string, repoMap map[string]*Repository) (bool, error) {
+	if len(languages) == 0 {
+		languages = []string{""}
+	}
+
+	qualifiers := " archived:false"
+	if opts.IncludeArchived {
+		qualifiers = ""
+	}
+
+	issueCounts := make(map[string]int)
+	incomplete := false
+	for _, lang := range languages {
+		if ctx.Err() != nil {
+			return false, c.describeTimeout(ctx.Err(), ctx)
+		}
+
+		query := "label:hacktoberfest is:issue is:open" + qualifiers
+		if lang != "" {
+			query += fmt.Sprintf(" language:%s", strings.ToLower(lang))
+		}
+		logger.Info(fmt.Sprintf("Labelled issue search query: %s", query))
+
+		var result *github.IssuesSearchResult
+		err := c.withRateLimitRetry(ctx, "labelled issue search", func() error {
+			reqCtx, cancel := c.requestContext(ctx)
+			defer cancel()
+
+			start := time.Now()
+			var response *github.Response
+			var err error
+			result, response, err = c.client.Search.Issues(reqCtx, query, &github.SearchOptions{
+				Sort:        "updated",
+				ListOptions: github.ListOptions{PerPage: 100},
+			})
+			if response != nil {
+				logger.LogAPIRequest("search/issues", query, response.StatusCode, time.Since(start))
+			}
+			return err
+		})
+		if invalid := asInvalidQuery(err, query); invalid != nil {
+			logger.ErrorWithErr(fmt.Sprintf("GitHub rejected search query: %s", query), invalid)
+			return false, invalid
+		}
+		if err != nil {
+			err = c.describeTimeout(err, ctx)
+			logger.ErrorWithErr(fmt.Sprintf("Failed to search labelled issues for language: %s", lang), err)
+			if ctx.Err() != nil || errors.Is(err, ErrRateLimited) {
+				return false, err
+			}
+			continue
+		}
+
+		if result.GetIncompleteResults() {
+			incomplete = true
+			logger.Warn(fmt.Sprintf("GitHub search timed out and returned partial results for query: %s", query))
+		}
+		for _, issue := range result.Issues {
+			// The repository URL looks like https://api.github.com/repos/owner/name
+			if _, name, ok := strings.Cut(issue.GetRepositoryURL(), "/repos/"); ok {
+				issueCounts[name]++
+			}
+		}
+	}
+
+	// Fetch the repositories not found otherwise, most labelled issues first
+	var names []string
+	for name := range issueCounts {
+		if _, exists := repoMap[name]; !exists {
+			names = append(names, name)
+		}
+	}
+	sort.Slice(names, func(i, j int) bool {
+		if issueCounts[names[i]] != issueCounts[names[j]] {
+			return issueCounts[names[i]] > issueCounts[names[j]]
+		}
+		return names[i] < names[j]
+	})
+	if len(names) > labelDiscoveryRepos {
+		names = names[:labelDiscoveryRepos]
+	}
+
+	added := 0
+	for _, name := range names {
+		if ctx.Err() != nil {
+			return incomplete, c.describeTimeout(ctx.Err(), ctx)
+		}
+
+		owner, repoName, _ := strings.Cut(name, "/")
+		reqCtx, cancel := c.requestContext(ctx)
+		start := time.Now()
+		repo, response, err := c.client.Repositories.Get(reqCtx, owner, repoName)
+		cancel()
+		if response != nil {
+			logger.LogAPIRequest("repos/get", name, response.StatusCode, time.Since(start))
+		}
+		if err != nil {
+			logger.ErrorWithErr(fmt.Sprintf("Failed to fetch labelled repository %s", name), c.describeTimeout(err, ctx))
+			continue
+		}
+
+		if repo.GetStargazersCount() < opts.MinStars || opts.skipRepository(repo, name) {
+			continue
+		}
+		if opts.Topic != "" && !hasTopic(repo, opts.Topic) {
+			logger.Debug(fmt.Sprintf("Repository %s lacks the %s topic, skipping", name, opts.Topic))
+			continue
+		}
+
+		r := opts.newRepository(repo, name)
+		r.calculateRelevance(opts.Languages, opts.Weights)
+		repoMap[name] = r
+		added++
+	}
+
+	logger.Info(fmt.Sprintf("Labelled issue search found %d repositories, added %d", len(issueCounts), added))
+	return incomplete, nil
+}
+
+// hasTopic reports whether a repository carries topic, case-insensitively
+func hasTopic(repo *github.Repository, topic string) bool {
+	for _, t := range repo.Topics {
+		if strings.EqualFold(t, topic) {
+			return true
+		}
+	}
+	return false
+}
+
+// HasTopic reports whether the repository carries topic, case-insensitively
+func (r *Repository) HasTopic(topic string) bool {
+	return hasTopic(r.Repository, topic)
+}
@@ -0,0 +1,45 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"net/http"
+	"strings"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// InvalidQueryError reports a search query GitHub rejected as invalid, with
+// the validation messages from the response so the user can fix their input
+type InvalidQueryError struct {
+	Query    string
+	Messages []string
+}
+
+func (e *InvalidQueryError) Error() string {
+	return fmt.Sprintf("Invalid query: %s", strings.Join(e.Messages, "; "))
+}
+
+// asInvalidQuery converts a 422 Unprocessable Entity response for query into
+// an InvalidQueryError, returning nil for any other error
+func asInvalidQuery(err error, query string) *InvalidQueryError {
+	var errResp *github.ErrorResponse
+	if !errors.As(err, &errResp) || errResp.Response == nil || errResp.Response.StatusCode != http.StatusUnprocessableEntity {
+		return nil
+	}
+
+	var messages []string
+	for _, detail := range errResp.Errors {
+		switch {
+		case detail.Message != "":
+			messages = append(messages, detail.Message)
+		case detail.Field != "":
+			messages = append(messages, fmt.Sprintf("%s %s", detail.Field, detail.Code))
+		}
+	}
+	if len(messages) == 0 {
+		messages = append(messages, errResp.Message)
+	}
+
+	return &InvalidQueryError{Query: query, Messages: messages}
+}
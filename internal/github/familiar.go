@@ -0,0 +1,112 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// familiarPages bounds how many pages of 100 starred repositories and pull
+// requests are read when looking up the user's familiar repositories
+const familiarPages = 3
+
+// Familiarity records how the user already knows a repository
+type Familiarity struct {
+	Starred     bool
+	Contributed bool // the user has opened a pull request against it
+}
+
+// familiarRepos returns the repositories the authenticated user has starred
+// or opened pull requests against, keyed by lowercase "owner/name". They are
+// fetched once per client; failures are logged and leave the set partial.
+func (c *Client) familiarRepos(ctx context.Context) map[string]Familiarity {
+	c.mu.Lock()
+	familiar := c.familiar
+	c.mu.Unlock()
+	if familiar != nil {
+		return familiar
+	}
+
+	familiar = make(map[string]Familiarity)
+	for _, name := range c.starredRepos(ctx) {
+		f := familiar[name]
+		f.Starred = true
+		familiar[name] = f
+	}
+	for _, name := range c.contributedRepos(ctx) {
+		f := familiar[name]
+		f.Contributed = true
+		familiar[name] = f
+	}
+	logger.Info(fmt.Sprintf("Found %d repositories you starred or contributed to", len(familiar)))
+
+	c.mu.Lock()
+	c.familiar = familiar
+	c.mu.Unlock()
+	return familiar
+}
+
+// starredRepos lists the repositories the authenticated user has starred
+func (c *Client) starredRepos(ctx context.Context) []string {
+	var names []string
+	opts := &github.ActivityListStarredOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 1; page <= familiarPages; page++ {
+		opts.Page = page
+		reqCtx, cancel := c.requestContext(ctx)
+		start := time.Now()
+		starred, response, err := c.client.Activity.ListStarred(reqCtx, "", opts)
+		cancel()
+		if response != nil {
+			logger.LogAPIRequest("activity/starred", fmt.Sprintf("page %d", page), response.StatusCode, time.Since(start))
+		}
+		if err != nil {
+			logger.ErrorWithErr("Failed to list starred repositories", c.describeTimeout(err, ctx))
+			break
+		}
+
+		for _, s := range starred {
+			names = append(names, strings.ToLower(s.GetRepository().GetFullName()))
+		}
+		if response.NextPage == 0 {
+			break
+		}
+	}
+	return names
+}
+
+// contributedRepos lists the repositories the authenticated user has opened
+// pull requests against
+func (c *Client) contributedRepos(ctx context.Context) []string {
+	var names []string
+	opts := &github.SearchOptions{ListOptions: github.ListOptions{PerPage: 100}}
+	for page := 1; page <= familiarPages; page++ {
+		opts.Page = page
+		reqCtx, cancel := c.requestContext(ctx)
+		start := time.Now()
+		result, response, err := c.client.Search.Issues(reqCtx, "type:pr author:@me", opts)
+		cancel()
+		if response != nil {
+			logger.LogAPIRequest("search/issues", "type:pr author:@me", response.StatusCode, time.Since(start))
+		}
+		if err != nil {
+			logger.ErrorWithErr("Failed to search your pull requests", c.describeTimeout(err, ctx))
+			break
+		}
+
+		for _, pr := range result.Issues {
+			// The repository URL looks like https://api.github.com/repos/owner/name
+			if _, name, ok := strings.Cut(pr.GetRepositoryURL(), "/repos/"); ok {
+				names = append(names, strings.ToLower(name))
+			}
+		}
+		if response.NextPage == 0 {
+			break
+		}
+	}
+	return names
+}
@@ -0,0 +1,106 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"net/http"
+	"strconv"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// ErrRateLimited is returned, wrapped with when the limit resets, once a
+// request is still rate limited after every retry allowed by
+// SetRateLimitRetries
+var ErrRateLimited = errors.New("GitHub rate limit exceeded")
+
+// secondaryLimitWait is how long to wait after a secondary rate limit
+// response that doesn't say, as GitHub recommends
+const secondaryLimitWait = time.Minute
+
+// retryConfig bounds how rate limited requests are retried
+type retryConfig struct {
+	maxRetries int           // retries per request, 0 fails straight away
+	maxWait    time.Duration // total time one request may spend waiting
+}
+
+// SetRateLimitRetries sets how often a rate limited request is retried after
+// waiting for the limit to reset, and the most time it may wait in total. A
+// request whose limit resets later than that fails with ErrRateLimited
+// without waiting.
+func (c *Client) SetRateLimitRetries(retries int, maxWait time.Duration) {
+	c.mu.Lock()
+	c.retry = retryConfig{maxRetries: max(0, retries), maxWait: maxWait}
+	c.mu.Unlock()
+}
+
+// withRateLimitRetry runs call, waiting out primary and secondary rate
+// limits and retrying as configured. The wait ends early when ctx is done.
+func (c *Client) withRateLimitRetry(ctx context.Context, name string, call func() error) error {
+	c.mu.Lock()
+	retry := c.retry
+	c.mu.Unlock()
+
+	waited := time.Duration(0)
+	for attempt := 0; ; attempt++ {
+		err := call()
+		wait, reset, limited := rateLimitWait(err)
+		if !limited {
+			return err
+		}
+
+		if attempt >= retry.maxRetries || waited+wait > retry.maxWait {
+			logger.ErrorWithErr(fmt.Sprintf("Giving up on %s after %d retries", name, attempt), err)
+			return fmt.Errorf("%w, resets at %s", ErrRateLimited, reset.Format("15:04:05"))
+		}
+
+		logger.Warn(fmt.Sprintf("Rate limited on %s, retrying in %v (%d/%d)", name, wait.Round(time.Second), attempt+1, retry.maxRetries))
+		timer := time.NewTimer(wait)
+		select {
+		case <-timer.C:
+		case <-ctx.Done():
+			timer.Stop()
+			return c.describeTimeout(ctx.Err(), ctx)
+		}
+		waited += wait
+	}
+}
+
+// rateLimitWait reports whether err is a rate limit response and, if so, how
+// long to wait before retrying and when the limit resets. Primary limits
+// reset at X-RateLimit-Reset; secondary limits and other 429 responses say
+// how long to wait in Retry-After.
+func rateLimitWait(err error) (time.Duration, time.Time, bool) {
+	var rateErr *github.RateLimitError
+	if errors.As(err, &rateErr) {
+		reset := rateErr.Rate.Reset.Time
+		wait := time.Until(reset)
+		if wait < time.Second {
+			wait = time.Second // the reset time has only just passed
+		}
+		return wait, reset, true
+	}
+
+	var abuseErr *github.AbuseRateLimitError
+	if errors.As(err, &abuseErr) {
+		wait := secondaryLimitWait
+		if abuseErr.RetryAfter != nil {
+			wait = *abuseErr.RetryAfter
+		}
+		return wait, time.Now().Add(wait), true
+	}
+
+	var errResp *github.ErrorResponse
+	if errors.As(err, &errResp) && errResp.Response != nil && errResp.Response.StatusCode == http.StatusTooManyRequests {
+		wait := secondaryLimitWait
+		if seconds, convErr := strconv.Atoi(errResp.Response.Header.Get("Retry-After")); convErr == nil {
+			wait = time.Duration(seconds) * time.Second
+		}
+		return wait, time.Now().Add(wait), true
+	}
+	return 0, time.Time{}, false
+}
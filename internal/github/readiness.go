@@ -0,0 +1,144 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"hacktober/internal/logger"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// Readiness holds the signals that make a repository welcoming to new
+// contributors
+type Readiness struct {
+	HasContributing    bool
+	HasGoodFirstIssues bool
+	RecentlyActive     bool
+	MergesExternalPRs  bool
+	HasLicense         bool
+}
+
+// Score returns the number of readiness signals present, from 0 to 5
+func (r *Readiness) Score() int {
+	score := 0
+	for _, signal := range []bool{r.HasContributing, r.HasGoodFirstIssues, r.RecentlyActive, r.MergesExternalPRs, r.HasLicense} {
+		if signal {
+			score++
+		}
+	}
+	return score
+}
+
+// MaxReadinessScore is the highest possible readiness score
+const MaxReadinessScore = 5
+
+// externalAssociations are the PR author associations that count as outside contributors
+var externalAssociations = map[string]bool{
+	"CONTRIBUTOR":            true,
+	"FIRST_TIME_CONTRIBUTOR": true,
+	"FIRST_TIMER":            true,
+	"NONE":                   true,
+}
+
+// ReadinessOf computes the contribution readiness of each repository. This
+// costs three API calls per repository, so callers should only use it when
+// readiness checks are enabled. Results are cached. The repositories are left
+// untouched, since the UI may be showing them; callers set Repository.Readiness
+// from the returned map themselves. It also returns the number of API calls
+// made.
+func (c *Client) ReadinessOf(repos []*Repository) (map[*Repository]*Readiness, int) {
+	ctx, calls := countAPICalls(c.ctx)
+	readiness := make(map[*Repository]*Readiness, len(repos))
+	for _, repo := range repos {
+		repoName := repoFullName(repo.Repository)
+
+		c.mu.Lock()
+		cached, ok := c.readinessCache[repoName]
+		c.mu.Unlock()
+		if !ok {
+			cached = c.fetchReadiness(ctx, repo.Repository)
+
+			c.mu.Lock()
+			c.readinessCache[repoName] = cached
+			c.mu.Unlock()
+		}
+
+		readiness[repo] = cached
+	}
+	return readiness, int(calls.Load())
+}
+
+// fetchReadiness collects the readiness signals for a single repository.
+// Failed checks are logged and count as missing signals.
+func (c *Client) fetchReadiness(ctx context.Context, repo *github.Repository) *Readiness {
+	start := time.Now()
+	owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+	repoName := repoFullName(repo)
+
+	readiness := &Readiness{
+		HasLicense:     repo.License != nil,
+		RecentlyActive: repo.GetPushedAt().After(time.Now().AddDate(0, -1, 0)),
+	}
+
+	// Community profile tells us about CONTRIBUTING (and the license, if search didn't)
+	reqCtx, cancel := c.requestContext(ctx)
+	health, response, err := c.client.Repositories.GetCommunityHealthMetrics(reqCtx, owner, name)
+	cancel()
+	if response != nil {
+		logger.LogAPIRequest("repos/community/profile", repoName, response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch community profile for %s", repoName), c.describeTimeout(err, ctx))
+	} else if health.Files != nil {
+		readiness.HasContributing = health.Files.Contributing != nil
+		readiness.HasLicense = readiness.HasLicense || health.Files.License != nil
+	}
+
+	// A single open "good first issue" is enough
+	issueOpts := &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{"good first issue"},
+		ListOptions: github.ListOptions{PerPage: 1},
+	}
+	reqCtx, cancel = c.requestContext(ctx)
+	issues, response, err := c.client.Issues.ListByRepo(reqCtx, owner, name, issueOpts)
+	cancel()
+	if response != nil {
+		logger.LogAPIRequest("issues/list", repoName+" good first issue", response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		logger.ErrorWithErr(fmt.Sprintf("Failed to check good first issues for %s", repoName), c.describeTimeout(err, ctx))
+	} else {
+		readiness.HasGoodFirstIssues = len(issues) > 0
+	}
+
+	// Look for a merged PR from an outside contributor among recently closed PRs
+	prOpts := &github.PullRequestListOptions{
+		State:       "closed",
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: 30},
+	}
+	reqCtx, cancel = c.requestContext(ctx)
+	prs, response, err := c.client.PullRequests.List(reqCtx, owner, name, prOpts)
+	cancel()
+	if response != nil {
+		logger.LogAPIRequest("pulls/list", repoName, response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		logger.ErrorWithErr(fmt.Sprintf("Failed to check merged PRs for %s", repoName), c.describeTimeout(err, ctx))
+	} else {
+		for _, pr := range prs {
+			if pr.MergedAt != nil && externalAssociations[pr.GetAuthorAssociation()] {
+				readiness.MergesExternalPRs = true
+				break
+			}
+		}
+	}
+
+	logger.Debug(fmt.Sprintf("Readiness for %s: %d/%d, took %v", repoName, readiness.Score(), MaxReadinessScore, time.Since(start)))
+
+	return readiness
+}
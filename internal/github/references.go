@@ -0,0 +1,94 @@
+package github
+
+import (
+	"errors"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"hacktober/internal/logger"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// ParseIssueReference splits an "owner/repo#number" reference into its parts
+func ParseIssueReference(ref string) (string, string, int, error) {
+	repoPart, numberPart, ok := strings.Cut(strings.TrimSpace(ref), "#")
+	if !ok {
+		return "", "", 0, fmt.Errorf("invalid issue reference %q: missing #number", ref)
+	}
+
+	owner, repo, ok := strings.Cut(repoPart, "/")
+	if !ok || owner == "" || repo == "" {
+		return "", "", 0, fmt.Errorf("invalid issue reference %q: expected owner/repo", ref)
+	}
+
+	number, err := strconv.Atoi(numberPart)
+	if err != nil || number <= 0 {
+		return "", "", 0, fmt.Errorf("invalid issue reference %q: bad issue number", ref)
+	}
+
+	return owner, repo, number, nil
+}
+
+// ParseRepoReference splits an "owner/name" repository reference into its parts
+func ParseRepoReference(ref string) (string, string, error) {
+	owner, name, ok := strings.Cut(strings.TrimSpace(ref), "/")
+	if !ok || owner == "" || name == "" || strings.ContainsAny(name, "/ #") || strings.ContainsAny(owner, " #") {
+		return "", "", fmt.Errorf("invalid repository %q: expected owner/name", ref)
+	}
+	return owner, name, nil
+}
+
+// RepositoryRef returns a minimal repository carrying only its owner and
+// name, enough to fetch its issues and link to it without a search
+func RepositoryRef(owner, name string) *Repository {
+	return &Repository{
+		Repository: &github.Repository{
+			Owner:   &github.User{Login: github.String(owner)},
+			Name:    github.String(name),
+			HTMLURL: github.String(fmt.Sprintf("https://github.com/%s/%s", owner, name)),
+		},
+	}
+}
+
+// GetIssuesByReference fetches the current state of each "owner/repo#number"
+// issue. Invalid or unreachable references are skipped; an error is only
+// returned if none of the references could be fetched.
+func (c *Client) GetIssuesByReference(refs []string) ([]*Issue, error) {
+	logger.Info(fmt.Sprintf("Fetching %d issues by reference", len(refs)))
+
+	result := make([]*Issue, 0, len(refs))
+	var errs []error
+
+	for _, ref := range refs {
+		owner, repo, number, err := ParseIssueReference(ref)
+		if err != nil {
+			logger.Warn(err.Error())
+			errs = append(errs, err)
+			continue
+		}
+
+		// Always fetch live state, the watchlist should reflect GitHub right now
+		issue, err := c.fetchIssue(owner, repo, number)
+		if err != nil {
+			errs = append(errs, fmt.Errorf("%s: %w", ref, err))
+			continue
+		}
+
+		// Issues fetched one by one don't carry their repository, so attach a
+		// minimal one for display and navigation
+		if issue.Repository == nil {
+			issue.Repository = RepositoryRef(owner, repo)
+		}
+		result = append(result, issue)
+	}
+
+	logger.Info(fmt.Sprintf("Fetched %d of %d referenced issues", len(result), len(refs)))
+
+	if len(result) == 0 && len(errs) > 0 {
+		return nil, errors.Join(errs...)
+	}
+
+	return result, nil
+}
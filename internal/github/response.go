@@ -0,0 +1,78 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// firstResponseComments is how many of an issue's earliest comments are
+// searched for the first one by someone other than its author
+const firstResponseComments = 30
+
+// EnrichFirstResponses sets FirstResponse on every issue of owner/repo with
+// comments to the time between the issue being opened and its first comment
+// by someone other than the author. It costs one API call per commented
+// issue, cached per issue, and returns the number of calls made.
+func (c *Client) EnrichFirstResponses(owner, repo string, issues []*Issue) int {
+	ctx, calls := countAPICalls(c.ctx)
+	for _, issue := range issues {
+		if issue.Issue.GetComments() == 0 {
+			continue
+		}
+
+		key := fmt.Sprintf("%s/%s#%d", owner, repo, issue.Issue.GetNumber())
+		c.mu.Lock()
+		cached, ok := c.responseCache[key]
+		c.mu.Unlock()
+		if !ok {
+			var err error
+			cached, err = c.fetchFirstResponse(ctx, owner, repo, issue)
+			if err != nil {
+				logger.ErrorWithErr(fmt.Sprintf("Failed to fetch comments of %s", key), err)
+				continue
+			}
+
+			c.mu.Lock()
+			c.responseCache[key] = cached
+			c.mu.Unlock()
+		}
+		issue.FirstResponse = cached
+	}
+	return int(calls.Load())
+}
+
+// fetchFirstResponse returns how long an issue waited for a comment from
+// someone other than its author, or nil if none of its earliest comments is
+func (c *Client) fetchFirstResponse(ctx context.Context, owner, name string, issue *Issue) (*time.Duration, error) {
+	reqCtx, cancel := c.requestContext(ctx)
+	defer cancel()
+
+	start := time.Now()
+	opts := &github.IssueListCommentsOptions{
+		Sort:        github.String("created"),
+		Direction:   github.String("asc"),
+		ListOptions: github.ListOptions{PerPage: firstResponseComments},
+	}
+	comments, response, err := c.client.Issues.ListComments(reqCtx, owner, name, issue.Issue.GetNumber(), opts)
+	if response != nil {
+		logger.LogAPIRequest("issues/comments", fmt.Sprintf("%s/%s#%d", owner, name, issue.Issue.GetNumber()), response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		return nil, c.describeTimeout(err, ctx)
+	}
+
+	author := issue.Issue.GetUser().GetLogin()
+	for _, comment := range comments {
+		if comment.GetUser().GetLogin() == author {
+			continue
+		}
+		wait := comment.GetCreatedAt().Sub(issue.Issue.GetCreatedAt().Time)
+		return &wait, nil
+	}
+	return nil, nil
+}
@@ -0,0 +1,171 @@
+package github
+
+import (
+	"fmt"
+	"strings"
+	"sync"
+	"time"
+
+	"hacktober/internal/logger"
+)
+
+// resultCache keeps repository search pages and issue lists for a fixed time,
+// so re-running a search doesn't spend rate limit. Entries expire lazily when
+// they are read.
+type resultCache struct {
+	ttl time.Duration
+
+	mu       sync.Mutex
+	searches map[string]cachedSearch
+	issues   map[string]cachedIssues
+}
+
+// cachedSearch is one page of a repository search with its total count
+type cachedSearch struct {
+	result  RepoSearchResult
+	expires time.Time
+}
+
+// cachedIssues is the issue list of one repository for one set of filters
+type cachedIssues struct {
+	stats   IssueStats
+	expires time.Time
+}
+
+func newResultCache(ttl time.Duration) *resultCache {
+	return &resultCache{
+		ttl:      ttl,
+		searches: make(map[string]cachedSearch),
+		issues:   make(map[string]cachedIssues),
+	}
+}
+
+// NewClientWithCache creates a GitHub API client that reuses repository
+// search pages and issue lists for ttl. A ttl of 0 or less disables the cache.
+func NewClientWithCache(token string, ttl time.Duration) *Client {
+	c := NewClient(token)
+	if ttl > 0 {
+		c.results = newResultCache(ttl)
+	}
+	return c
+}
+
+// searchCacheKey identifies a search page by its query criteria, page size
+// and page number
+func searchCacheKey(opts RepoSearchOptions, maxResults, page int) string {
+	return fmt.Sprintf("%v|%d|%d", opts, maxResults, page)
+}
+
+// issuesCacheKey identifies an issue list by repository, labels, size and
+// filter. It starts with "owner/repo|" so a repository's lists can be dropped
+// together.
+func issuesCacheKey(owner, repo string, labels []string, maxResults int, filter IssueFilter) string {
+	return fmt.Sprintf("%s/%s|%v|%d|%v", owner, repo, labels, maxResults, filter)
+}
+
+// search returns a cached search page, or false if there is none or it expired
+func (rc *resultCache) search(key string) (*RepoSearchResult, bool) {
+	if rc == nil {
+		return nil, false
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.searches[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(rc.searches, key)
+		return nil, false
+	}
+	result := entry.result
+	result.APICalls = 0
+	return &result, true
+}
+
+func (rc *resultCache) storeSearch(key string, result *RepoSearchResult) {
+	if rc == nil {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.searches[key] = cachedSearch{result: *result, expires: time.Now().Add(rc.ttl)}
+}
+
+// issueStats returns a cached issue list, or false if there is none or it expired
+func (rc *resultCache) issueStats(key string) (*IssueStats, bool) {
+	if rc == nil {
+		return nil, false
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+
+	entry, ok := rc.issues[key]
+	if !ok {
+		return nil, false
+	}
+	if time.Now().After(entry.expires) {
+		delete(rc.issues, key)
+		return nil, false
+	}
+	stats := entry.stats
+	stats.APICalls = 0
+	return &stats, true
+}
+
+func (rc *resultCache) storeIssueStats(key string, stats *IssueStats) {
+	if rc == nil {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	rc.issues[key] = cachedIssues{stats: *stats, expires: time.Now().Add(rc.ttl)}
+}
+
+func (rc *resultCache) clearSearches() {
+	if rc == nil {
+		return
+	}
+
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	clear(rc.searches)
+}
+
+// ClearRepositoryIssuesCache discards the cached issue lists and issue details
+// of owner/repo so the next load queries GitHub again
+func (c *Client) ClearRepositoryIssuesCache(owner, repo string) {
+	detailPrefix := fmt.Sprintf("%s/%s#", owner, repo)
+	c.mu.Lock()
+	for key := range c.issueCache {
+		if strings.HasPrefix(key, detailPrefix) {
+			delete(c.issueCache, key)
+		}
+	}
+	c.mu.Unlock()
+
+	rc := c.results
+	if rc == nil {
+		return
+	}
+
+	prefix := fmt.Sprintf("%s/%s|", owner, repo)
+	rc.mu.Lock()
+	defer rc.mu.Unlock()
+	for key := range rc.issues {
+		if strings.HasPrefix(key, prefix) {
+			delete(rc.issues, key)
+		}
+	}
+}
+
+// logCacheHit notes a result served from the cache, so the log still shows
+// every search that was run
+func logCacheHit(what string) {
+	logger.Info(fmt.Sprintf("Result cache hit for %s, no API calls made", what))
+}
@@ -0,0 +1,237 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"sort"
+	"sync"
+	"time"
+
+	"hacktober/internal/logger"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// ScanProgress reports how far a cross-repo issue scan has come
+type ScanProgress struct {
+	Scanned int
+	Total   int
+	Found   int
+	Issues  []*Issue // issues found in the repository just scanned
+}
+
+// ScanControl pauses, resumes and cancels a running scan. Pausing stops new
+// repositories from being scanned; requests already in flight still finish.
+type ScanControl struct {
+	ctx    context.Context
+	cancel context.CancelFunc
+
+	mu     sync.Mutex
+	paused bool
+	resume chan struct{} // closed when a paused scan resumes
+}
+
+// NewScanControl returns a control for a scan run within the client's context
+func (c *Client) NewScanControl() *ScanControl {
+	ctx, cancel := context.WithCancel(c.ctx)
+	return &ScanControl{ctx: ctx, cancel: cancel}
+}
+
+// Pause stops the scan from starting on further repositories
+func (s *ScanControl) Pause() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if !s.paused {
+		s.paused = true
+		s.resume = make(chan struct{})
+	}
+}
+
+// Resume continues a paused scan
+func (s *ScanControl) Resume() {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.paused {
+		s.paused = false
+		close(s.resume)
+	}
+}
+
+// Paused reports whether the scan is paused
+func (s *ScanControl) Paused() bool {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	return s.paused
+}
+
+// Cancel stops the scan, aborting requests in flight
+func (s *ScanControl) Cancel() {
+	s.cancel()
+}
+
+// wait blocks while the scan is paused and reports whether it may go on
+func (s *ScanControl) wait() bool {
+	s.mu.Lock()
+	resume := s.resume
+	paused := s.paused
+	s.mu.Unlock()
+
+	if paused {
+		select {
+		case <-resume:
+		case <-s.ctx.Done():
+		}
+	}
+	return s.ctx.Err() == nil
+}
+
+// ScanResult holds the issues found by a cross-repo scan. Partial is set when
+// the scan stopped early, with Note explaining why.
+type ScanResult struct {
+	Issues  []*Issue
+	Scanned int
+	Total   int
+	Partial bool
+	Note    string
+}
+
+// Scan tuning
+const (
+	scanIssuesPerRepo = 10 // issues fetched per repository
+	scanRateReserve   = 10 // stop scanning when fewer API requests than this remain
+)
+
+// ScanGoodFirstIssues fetches open "good first issue" issues from every
+// repository, running at most concurrency requests at once. Progress, with
+// the issues each repository turned up, is sent on the progress channel,
+// which is closed when the scan finishes. The scan stops early and returns
+// partial results if the API rate limit is hit or control cancels it.
+func (c *Client) ScanGoodFirstIssues(repos []*Repository, concurrency int, control *ScanControl, progress chan<- ScanProgress) *ScanResult {
+	defer close(progress)
+
+	start := time.Now()
+	concurrency = max(1, concurrency)
+	logger.Info(fmt.Sprintf("Starting good first issue scan of %d repos with concurrency %d", len(repos), concurrency))
+
+	var (
+		mu      sync.Mutex
+		wg      sync.WaitGroup
+		result  = &ScanResult{Total: len(repos)}
+		stopped bool
+	)
+	sem := make(chan struct{}, concurrency)
+
+	for _, repo := range repos {
+		if !control.wait() {
+			mu.Lock()
+			stopped = true
+			result.Note = "scan cancelled"
+			mu.Unlock()
+		}
+
+		mu.Lock()
+		if stopped {
+			mu.Unlock()
+			break
+		}
+		mu.Unlock()
+
+		sem <- struct{}{}
+		wg.Add(1)
+		go func(repo *Repository) {
+			defer wg.Done()
+			defer func() { <-sem }()
+
+			issues, remaining, err := c.scanRepository(control.ctx, repo)
+
+			mu.Lock()
+			defer mu.Unlock()
+
+			result.Scanned++
+			result.Issues = append(result.Issues, issues...)
+
+			var rateErr *github.RateLimitError
+			var abuseErr *github.AbuseRateLimitError
+			switch {
+			case control.ctx.Err() != nil:
+				result.Note = "scan cancelled"
+			case errors.As(err, &rateErr) || errors.As(err, &abuseErr):
+				stopped = true
+				result.Note = "GitHub rate limit reached"
+			case err == nil && remaining < scanRateReserve:
+				stopped = true
+				result.Note = fmt.Sprintf("stopped to keep %d API requests in reserve", scanRateReserve)
+			}
+
+			progress <- ScanProgress{Scanned: result.Scanned, Total: result.Total, Found: len(result.Issues), Issues: issues}
+		}(repo)
+	}
+
+	wg.Wait()
+
+	result.Partial = result.Scanned < result.Total
+
+	// Workers finish in any order, so sort easiest first for a stable list
+	sort.Slice(result.Issues, func(i, j int) bool {
+		a, b := result.Issues[i], result.Issues[j]
+		if a.DifficultyScore != b.DifficultyScore {
+			// Issues of unknown difficulty go after the hardest ones
+			return a.DifficultyScore != UnknownDifficulty &&
+				(b.DifficultyScore == UnknownDifficulty || a.DifficultyScore < b.DifficultyScore)
+		}
+		if nameA, nameB := repoFullName(a.Repository.Repository), repoFullName(b.Repository.Repository); nameA != nameB {
+			return nameA < nameB
+		}
+		return a.GetNumber() < b.GetNumber()
+	})
+	logger.Info(fmt.Sprintf("Good first issue scan finished: scanned %d/%d repos, found %d issues, partial: %t, took %v",
+		result.Scanned, result.Total, len(result.Issues), result.Partial, time.Since(start)))
+
+	return result
+}
+
+// scanRepository fetches the good first issues of a single repository and
+// returns them with the remaining API rate limit
+func (c *Client) scanRepository(parent context.Context, repo *Repository) ([]*Issue, int, error) {
+	start := time.Now()
+	owner, name := repo.GetOwner().GetLogin(), repo.GetName()
+	repoName := repoFullName(repo.Repository)
+
+	opts := &github.IssueListByRepoOptions{
+		State:       "open",
+		Labels:      []string{"good first issue"},
+		Sort:        "updated",
+		Direction:   "desc",
+		ListOptions: github.ListOptions{PerPage: scanIssuesPerRepo},
+	}
+
+	ctx, cancel := c.requestContext(parent)
+	defer cancel()
+
+	issues, response, err := c.client.Issues.ListByRepo(ctx, owner, name, opts)
+	remaining := scanRateReserve
+	if response != nil {
+		logger.LogAPIRequest("issues/list", repoName+" good first issue", response.StatusCode, time.Since(start))
+		remaining = response.Rate.Remaining
+	}
+	if err != nil {
+		err = c.describeTimeout(err, parent)
+		logger.ErrorWithErr(fmt.Sprintf("Failed to scan issues for %s", repoName), err)
+		return nil, remaining, err
+	}
+
+	result := make([]*Issue, 0, len(issues))
+	for _, issue := range issues {
+		if issue.PullRequestLinks != nil {
+			continue
+		}
+		i := newIssue(issue)
+		i.Repository = repo
+		c.trimBody(i)
+		c.scoreDifficulty(i)
+		result = append(result, i)
+	}
+
+	return result, remaining, nil
+}
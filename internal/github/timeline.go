@@ -0,0 +1,287 @@
+package github
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+	"time"
+
+	"hacktober/internal/logger"
+
+	"github.com/google/go-github/v56/github"
+)
+
+// LinkedPR is a pull request that cross-references an issue
+type LinkedPR struct {
+	Repository string
+	Number     int
+	Title      string
+	State      string // "open" or "closed"
+	URL        string
+}
+
+// IsOpen reports whether the pull request is still open
+func (pr LinkedPR) IsOpen() bool {
+	return pr.State == "open"
+}
+
+// ProjectCard is a project board an issue has been added to
+type ProjectCard struct {
+	ProjectID int64
+	Project   string // board name, or "Project <id>" if it could not be fetched
+	Column    string
+}
+
+// TimelineSignal is what a timeline event suggests about whether an issue is
+// still available
+type TimelineSignal string
+
+const (
+	SignalNone      TimelineSignal = ""
+	SignalTaken     TimelineSignal = "taken"     // assigned, claimed in a comment or an open PR
+	SignalAbandoned TimelineSignal = "abandoned" // assignee removed or linked PR closed
+)
+
+// TimelineEvent is one entry of an issue's condensed timeline
+type TimelineEvent struct {
+	Event   string // GitHub event name, such as "assigned" or "commented"
+	Actor   string
+	Summary string
+	At      time.Time
+	Signal  TimelineSignal
+}
+
+// IssueTimeline is what an issue's timeline says about the work around it
+type IssueTimeline struct {
+	LinkedPRs []LinkedPR
+	Projects  []ProjectCard   // classic project boards only, the REST timeline has no details for Projects v2
+	Events    []TimelineEvent // assignments, claims, PR references and state changes, oldest first
+}
+
+// LatestSignal returns the most recent event that says whether the issue is
+// taken, since a later unassignment outweighs an earlier claim and vice versa
+func LatestSignal(events []TimelineEvent) (TimelineEvent, bool) {
+	for i := len(events) - 1; i >= 0; i-- {
+		if events[i].Signal != SignalNone {
+			return events[i], true
+		}
+	}
+	return TimelineEvent{}, false
+}
+
+// GetIssueLinkedPRs fetches the pull requests that cross-reference an issue
+// by scanning its timeline. An open linked PR usually means someone is
+// already working on the issue.
+func (c *Client) GetIssueLinkedPRs(owner, repo string, number int) ([]LinkedPR, error) {
+	events, err := c.timelineEvents(c.ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+	return linkedPRs(events), nil
+}
+
+// GetIssueTimeline fetches an issue's timeline and extracts the pull requests
+// that cross-reference it and the project boards it is on. Each project board
+// costs one extra API call to look up its name.
+func (c *Client) GetIssueTimeline(owner, repo string, number int) (*IssueTimeline, error) {
+	events, err := c.timelineEvents(c.ctx, owner, repo, number)
+	if err != nil {
+		return nil, err
+	}
+
+	return &IssueTimeline{
+		LinkedPRs: linkedPRs(events),
+		Projects:  c.projectCards(events),
+		Events:    timelineActivity(events),
+	}, nil
+}
+
+// timelineEvents fetches the first page of an issue's timeline events
+func (c *Client) timelineEvents(parent context.Context, owner, repo string, number int) ([]*github.Timeline, error) {
+	start := time.Now()
+	issueKey := fmt.Sprintf("%s/%s#%d", owner, repo, number)
+
+	ctx, cancel := c.requestContext(parent)
+	defer cancel()
+
+	events, response, err := c.client.Issues.ListIssueTimeline(ctx, owner, repo, number, &github.ListOptions{PerPage: 100})
+	if response != nil {
+		logger.LogAPIRequest("issues/timeline", issueKey, response.StatusCode, time.Since(start))
+	}
+	if err != nil {
+		err = c.describeTimeout(err, parent)
+		logger.ErrorWithErr(fmt.Sprintf("Failed to fetch timeline for %s", issueKey), err)
+		return nil, fmt.Errorf("failed to fetch issue timeline: %w", err)
+	}
+
+	logger.Info(fmt.Sprintf("Fetched %d timeline events for %s", len(events), issueKey))
+
+	return events, nil
+}
+
+// linkedPRs extracts the distinct pull requests cross-referencing an issue
+func linkedPRs(events []*github.Timeline) []LinkedPR {
+	var linked []LinkedPR
+	seen := make(map[string]bool)
+	for _, event := range events {
+		if event.GetEvent() != "cross-referenced" || event.Source == nil || event.Source.Issue == nil {
+			continue
+		}
+
+		source := event.Source.Issue
+		if source.PullRequestLinks == nil {
+			continue // referenced from another issue, not a PR
+		}
+
+		pr := LinkedPR{
+			Repository: repoFullName(source.GetRepository()),
+			Number:     source.GetNumber(),
+			Title:      source.GetTitle(),
+			State:      source.GetState(),
+			URL:        source.GetHTMLURL(),
+		}
+		key := fmt.Sprintf("%s#%d", pr.Repository, pr.Number)
+		if seen[key] {
+			continue
+		}
+		seen[key] = true
+		linked = append(linked, pr)
+	}
+	return linked
+}
+
+// claimPhrases are lowercase comment fragments of someone taking an issue
+var claimPhrases = []string{
+	"i'll take", "i will take", "i'd like to work", "i would like to work", "i want to work",
+	"can i work on", "could i work on", "assign me", "assign this to me", "i'm working on", "i am working on",
+}
+
+// isClaim reports whether a comment reads like someone taking the issue
+func isClaim(body string) bool {
+	body = strings.ToLower(strings.ReplaceAll(body, "’", "'"))
+	for _, phrase := range claimPhrases {
+		if strings.Contains(body, phrase) {
+			return true
+		}
+	}
+	return false
+}
+
+// timelineActivity condenses timeline events to the ones that say whether
+// the issue is taken: assignments, claim comments, referencing pull requests
+// and closing or reopening. Other comments, labels and commits are dropped.
+func timelineActivity(events []*github.Timeline) []TimelineEvent {
+	var activity []TimelineEvent
+	for _, event := range events {
+		entry := TimelineEvent{
+			Event: event.GetEvent(),
+			Actor: event.GetActor().GetLogin(),
+			At:    event.GetCreatedAt().Time,
+		}
+
+		switch entry.Event {
+		case "assigned":
+			entry.Summary = fmt.Sprintf("assigned to %s", event.GetAssignee().GetLogin())
+			entry.Signal = SignalTaken
+		case "unassigned":
+			entry.Summary = fmt.Sprintf("%s unassigned", event.GetAssignee().GetLogin())
+			entry.Signal = SignalAbandoned
+		case "commented":
+			if !isClaim(event.GetBody()) {
+				continue
+			}
+			entry.Actor = event.GetUser().GetLogin() // comments carry the author in user
+			entry.Summary = fmt.Sprintf("%q", truncateComment(event.GetBody(), 60))
+			entry.Signal = SignalTaken
+		case "cross-referenced":
+			if event.Source == nil || event.Source.Issue == nil || event.Source.Issue.PullRequestLinks == nil {
+				continue
+			}
+			source := event.Source.Issue
+			entry.Summary = fmt.Sprintf("referenced in PR %s#%d (%s)", repoFullName(source.GetRepository()), source.GetNumber(), source.GetState())
+			if source.GetState() == "open" {
+				entry.Signal = SignalTaken
+			} else {
+				entry.Signal = SignalAbandoned
+			}
+		case "closed", "reopened":
+			entry.Summary = entry.Event
+		default:
+			continue
+		}
+		activity = append(activity, entry)
+	}
+	return activity
+}
+
+// truncateComment shortens a comment to its first line, at most limit runes
+func truncateComment(body string, limit int) string {
+	line, _, _ := strings.Cut(strings.TrimSpace(body), "\n")
+	if runes := []rune(line); len(runes) > limit {
+		return string(runes[:limit]) + "..."
+	}
+	return line
+}
+
+// projectCards replays the project events of a timeline to find the boards
+// the issue is currently on and the column it sits in on each
+func (c *Client) projectCards(events []*github.Timeline) []ProjectCard {
+	var order []int64
+	columns := make(map[int64]string)
+	for _, event := range events {
+		card := event.ProjectCard
+		if card == nil || card.ProjectID == nil {
+			continue
+		}
+
+		id := card.GetProjectID()
+		switch event.GetEvent() {
+		case "added_to_project", "converted_note_to_issue", "moved_columns_in_project":
+			if !slices.Contains(order, id) {
+				order = append(order, id)
+			}
+			columns[id] = card.GetColumnName()
+		case "removed_from_project":
+			delete(columns, id)
+		}
+	}
+
+	var cards []ProjectCard
+	for _, id := range order {
+		column, ok := columns[id]
+		if !ok {
+			continue
+		}
+		cards = append(cards, ProjectCard{ProjectID: id, Project: c.projectName(id), Column: column})
+	}
+	return cards
+}
+
+// projectName fetches the name of a classic project board, falling back to
+// its ID when the board is private or gone
+func (c *Client) projectName(id int64) string {
+	ctx, cancel := c.requestContext(c.ctx)
+	defer cancel()
+
+	start := time.Now()
+	project, response, err := c.client.Projects.GetProject(ctx, id)
+	if response != nil {
+		logger.LogAPIRequest("projects/get", fmt.Sprintf("%d", id), response.StatusCode, time.Since(start))
+	}
+	if err != nil || project.GetName() == "" {
+		logger.Debug(fmt.Sprintf("Could not fetch name of project %d: %v", id, err))
+		return fmt.Sprintf("Project %d", id)
+	}
+	return project.GetName()
+}
+
+// hasOpenLinkedPR reports whether any of the linked PRs is still open
+func hasOpenLinkedPR(prs []LinkedPR) bool {
+	for _, pr := range prs {
+		if pr.IsOpen() {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,53 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+)
+
+// SetTimeouts configures how long a single API request may take and how long
+// a whole repository search may take across all of its requests. Zero
+// disables the respective deadline.
+func (c *Client) SetTimeouts(request, search time.Duration) {
+	c.requestTimeout = request
+	c.searchTimeout = search
+}
+
+// requestContext derives the context for a single API call from parent,
+// applying the per-request timeout
+func (c *Client) requestContext(parent context.Context) (context.Context, context.CancelFunc) {
+	if c.requestTimeout <= 0 {
+		return context.WithCancel(parent)
+	}
+	return context.WithTimeout(parent, c.requestTimeout)
+}
+
+// searchContext derives the context for a whole repository search, applying
+// the overall search timeout
+func (c *Client) searchContext() (context.Context, context.CancelFunc) {
+	if c.searchTimeout <= 0 {
+		return context.WithCancel(c.ctx)
+	}
+	return context.WithTimeout(c.ctx, c.searchTimeout)
+}
+
+// Close cancels the client's requests in flight, including any wait for a
+// rate limit to reset. The client can't be used afterwards.
+func (c *Client) Close() {
+	c.cancel()
+}
+
+// describeTimeout annotates a deadline error with the timeout that tripped.
+// parent is the context the request context was derived from; if it has
+// expired the overall search timeout was hit, otherwise the request timeout.
+func (c *Client) describeTimeout(err error, parent context.Context) error {
+	if !errors.Is(err, context.DeadlineExceeded) {
+		return err
+	}
+	if parent.Err() != nil {
+		return fmt.Errorf("search timeout of %v exceeded: %w", c.searchTimeout, err)
+	}
+	return fmt.Errorf("request timeout of %v exceeded: %w", c.requestTimeout, err)
+}
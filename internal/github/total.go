@@ -0,0 +1,84 @@
+package github
+
+import (
+	"context"
+	"errors"
+	"fmt"
+	"time"
+
+	"github.com/google/go-github/v56/github"
+
+	"hacktober/internal/logger"
+)
+
+// UnknownTotal is the RepoSearchResult.TotalAvailable of a search whose
+// global count query failed, as opposed to one that found nothing
+const UnknownTotal = -1
+
+// totalRetryDelay is the pause before the first retry of the global count
+// query, doubled for each further retry
+const totalRetryDelay = 250 * time.Millisecond
+
+// SetTotalCountRetries sets how often a failed global count query is retried
+// before the total is reported as UnknownTotal
+func (c *Client) SetTotalCountRetries(retries int) {
+	c.mu.Lock()
+	c.totalRetries = max(0, retries)
+	c.mu.Unlock()
+}
+
+// fetchGlobalTotal runs the single-result query counting every matching
+// repository, retrying transient failures. A query GitHub rejects is returned
+// as an *InvalidQueryError straight away.
+func (c *Client) fetchGlobalTotal(ctx context.Context, query string) (int, error) {
+	c.mu.Lock()
+	retries := c.totalRetries
+	c.mu.Unlock()
+
+	var err error
+	for attempt := 0; attempt <= retries; attempt++ {
+		if attempt > 0 {
+			delay := totalRetryDelay << (attempt - 1)
+			logger.Warn(fmt.Sprintf("Global count query failed, retrying in %v (%d/%d): %v", delay, attempt, retries, err))
+			select {
+			case <-time.After(delay):
+			case <-ctx.Done():
+				return 0, c.describeTimeout(ctx.Err(), ctx)
+			}
+		}
+
+		var result *github.RepositoriesSearchResult
+		searchErr := c.withRateLimitRetry(ctx, "global count query", func() error {
+			start := time.Now()
+			reqCtx, cancel := c.requestContext(ctx)
+			defer cancel()
+
+			var response *github.Response
+			var err error
+			result, response, err = c.client.Search.Repositories(reqCtx, query, repoSearchOptions(1))
+			if response != nil {
+				logger.LogAPIRequest("repositories/search_total", query, response.StatusCode, time.Since(start))
+				logger.Debug(fmt.Sprintf("(Total) Rate limit remaining: %d, resets at: %v", response.Rate.Remaining, response.Rate.Reset.Time))
+			}
+			return err
+		})
+		if errors.Is(searchErr, ErrRateLimited) {
+			return 0, searchErr // already waited as long as allowed
+		}
+		if invalid := asInvalidQuery(searchErr, query); invalid != nil {
+			logger.ErrorWithErr(fmt.Sprintf("GitHub rejected search query: %s", query), invalid)
+			return 0, invalid
+		}
+		if searchErr == nil {
+			if result == nil || result.Total == nil {
+				return 0, errors.New("global count query returned no total")
+			}
+			if result.GetIncompleteResults() {
+				logger.Warn(fmt.Sprintf("GitHub count query timed out, the total may be too low: %s", query))
+			}
+			return *result.Total, nil
+		}
+		err = c.describeTimeout(searchErr, ctx)
+	}
+	return 0, err
+}
@@ -0,0 +1,32 @@
+package github
+
+import (
+	"context"
+	"net/http"
+	"sync/atomic"
+)
+
+// apiCallsKey is the context key of an operation's API call counter
+type apiCallsKey struct{}
+
+// countAPICalls returns a context whose API requests, including those of
+// contexts derived from it, are counted in the returned counter. Counting per
+// context keeps concurrent operations such as a background scan out of each
+// other's totals.
+func countAPICalls(parent context.Context) (context.Context, *atomic.Int64) {
+	calls := new(atomic.Int64)
+	return context.WithValue(parent, apiCallsKey{}, calls), calls
+}
+
+// countingTransport counts every request against the counter in its context,
+// if the request belongs to a counted operation
+type countingTransport struct {
+	base http.RoundTripper
+}
+
+func (t countingTransport) RoundTrip(req *http.Request) (*http.Response, error) {
+	if calls, ok := req.Context().Value(apiCallsKey{}).(*atomic.Int64); ok {
+		calls.Add(1)
+	}
+	return t.base.RoundTrip(req)
+}
@@ -0,0 +1,117 @@
+package history
+
+import (
+	"bufio"
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Event types recorded in the history file
+const (
+	EventSearch      = "search"
+	EventIssueOpened = "issue_opened"
+)
+
+// Entry is a single line in the search history file
+type Entry struct {
+	Time        time.Time `json:"time"`
+	Event       string    `json:"event"`
+	Languages   []string  `json:"languages,omitempty"`
+	MinStars    int       `json:"min_stars,omitempty"`
+	MaxStars    int       `json:"max_stars,omitempty"`
+	ResultCount int       `json:"result_count,omitempty"`
+	Repository  string    `json:"repository,omitempty"`
+	IssueNumber int       `json:"issue_number,omitempty"`
+
+	// Further search criteria, so a search can be run again as it was
+	MinOpenIssues   int    `json:"min_open_issues,omitempty"`
+	OwnerType       string `json:"owner_type,omitempty"`
+	IncludeArchived bool   `json:"include_archived,omitempty"`
+	Preset          string `json:"preset,omitempty"` // name of the filter preset in effect
+	Page            int    `json:"page,omitempty"`
+}
+
+// SearchSummary describes one search and the activity that followed it
+type SearchSummary struct {
+	Search       Entry
+	IssuesOpened int
+}
+
+// GetHistoryLocation returns the path of the search history file
+func GetHistoryLocation() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "history.jsonl")
+}
+
+// Append adds an entry to the end of the history file, creating it if needed
+func Append(entry Entry) error {
+	if entry.Time.IsZero() {
+		entry.Time = time.Now()
+	}
+
+	path := GetHistoryLocation()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(entry)
+	if err != nil {
+		return err
+	}
+
+	file, err := os.OpenFile(path, os.O_CREATE|os.O_WRONLY|os.O_APPEND, 0644)
+	if err != nil {
+		return err
+	}
+	defer file.Close()
+
+	_, err = file.Write(append(data, '\n'))
+	return err
+}
+
+// Load reads all entries from the history file. A missing file is not an
+// error, and malformed lines are skipped.
+func Load() ([]Entry, error) {
+	file, err := os.Open(GetHistoryLocation())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	}
+	if err != nil {
+		return nil, err
+	}
+	defer file.Close()
+
+	var entries []Entry
+	scanner := bufio.NewScanner(file)
+	for scanner.Scan() {
+		var entry Entry
+		if err := json.Unmarshal(scanner.Bytes(), &entry); err != nil {
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	return entries, scanner.Err()
+}
+
+// Summarize groups entries by search, counting the issues opened after each
+// search until the next one
+func Summarize(entries []Entry) []SearchSummary {
+	var summaries []SearchSummary
+
+	for _, entry := range entries {
+		switch entry.Event {
+		case EventSearch:
+			summaries = append(summaries, SearchSummary{Search: entry})
+		case EventIssueOpened:
+			if len(summaries) > 0 {
+				summaries[len(summaries)-1].IssuesOpened++
+			}
+		}
+	}
+
+	return summaries
+}
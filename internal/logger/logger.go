@@ -1,18 +1,38 @@
 package logger
 
 import (
+	"io"
 	"os"
 	"path/filepath"
+	"sync"
 	"time"
 
 	"github.com/rs/zerolog"
 	"github.com/rs/zerolog/log"
 )
 
+// Logger is safe for concurrent use once Initialize has returned: every event
+// is written with a single, mutex-guarded write, so lines from different
+// goroutines never interleave. It must not be reassigned afterwards.
 var Logger zerolog.Logger
 
-// Initialize sets up the logger to write to a file
+var (
+	initOnce sync.Once
+	initErr  error
+)
+
+// Initialize sets up the logger to write to a file. Only the first call does
+// any work; later calls return its result, so the logger is never swapped
+// out while goroutines are using it.
 func Initialize() error {
+	initOnce.Do(func() {
+		initErr = initialize()
+	})
+	return initErr
+}
+
+// initialize opens today's log file and points the logger at it
+func initialize() error {
 	// Create logs directory
 	homeDir, err := os.UserHomeDir()
 	if err != nil {
@@ -33,9 +53,20 @@ func Initialize() error {
 		return err
 	}
 
-	// Configure zerolog
+	configure(file)
+
+	Logger.Info().
+		Str("version", "1.0.0").
+		Str("log_file", logFile).
+		Msg("Hacktoberfest CLI started")
+
+	return nil
+}
+
+// configure points the package and global loggers at w, serializing writes
+func configure(w io.Writer) {
 	zerolog.TimeFieldFormat = time.RFC3339
-	Logger = zerolog.New(file).
+	Logger = zerolog.New(zerolog.SyncWriter(w)).
 		Level(zerolog.DebugLevel).
 		With().
 		Timestamp().
@@ -44,13 +75,6 @@ func Initialize() error {
 
 	// Also set global logger
 	log.Logger = Logger
-
-	Logger.Info().
-		Str("version", "1.0.0").
-		Str("log_file", logFile).
-		Msg("Hacktoberfest CLI started")
-
-	return nil
 }
 
 // Debug logs a debug message
@@ -78,7 +102,8 @@ func ErrorWithErr(msg string, err error) {
 	Logger.Error().Err(err).Msg(msg)
 }
 
-// WithFields creates a logger with additional fields
+// WithFields creates a logger with additional fields. The child logger shares
+// the package logger's writer, so it is as safe for concurrent use.
 func WithFields(fields map[string]interface{}) zerolog.Logger {
 	event := Logger.With()
 	for k, v := range fields {
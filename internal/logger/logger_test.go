@@ -0,0 +1,90 @@
+package logger
+
+import (
+	"bufio"
+	"bytes"
+	"encoding/json"
+	"fmt"
+	"os"
+	"strings"
+	"sync"
+	"testing"
+	"time"
+)
+
+// TestConcurrentLogging logs from many goroutines at once and checks that
+// every line is a complete JSON event. Run with -race to also catch data races.
+func TestConcurrentLogging(t *testing.T) {
+	var buf bytes.Buffer
+	configure(&buf)
+	t.Cleanup(func() { configure(&bytes.Buffer{}) })
+
+	const goroutines, perGoroutine = 50, 20
+
+	var wg sync.WaitGroup
+	for g := 0; g < goroutines; g++ {
+		wg.Add(1)
+		go func(g int) {
+			defer wg.Done()
+			for i := 0; i < perGoroutine; i++ {
+				switch i % 4 {
+				case 0:
+					Info(fmt.Sprintf("info %d/%d", g, i))
+				case 1:
+					Debug(fmt.Sprintf("debug %d/%d", g, i))
+				case 2:
+					LogAPIRequest("search/repositories", fmt.Sprintf("query %d/%d", g, i), 200, time.Millisecond)
+				default:
+					child := WithFields(map[string]interface{}{"goroutine": g})
+					child.Info().Msg("with fields")
+				}
+			}
+		}(g)
+	}
+	wg.Wait()
+
+	lines := 0
+	scanner := bufio.NewScanner(&buf)
+	for scanner.Scan() {
+		var event map[string]interface{}
+		if err := json.Unmarshal(scanner.Bytes(), &event); err != nil {
+			t.Fatalf("line %d is not a complete log event: %v: %q", lines+1, err, scanner.Text())
+		}
+		lines++
+	}
+
+	if want := goroutines * perGoroutine; lines != want {
+		t.Errorf("got %d log lines, want %d", lines, want)
+	}
+}
+
+func TestInitializeRunsOnce(t *testing.T) {
+	home := t.TempDir()
+	t.Setenv("HOME", home)
+	t.Setenv("USERPROFILE", home)
+
+	if err := Initialize(); err != nil {
+		t.Fatalf("Initialize returned error: %v", err)
+	}
+
+	// A second call must not reconfigure the logger under running goroutines
+	done := make(chan struct{})
+	go func() {
+		defer close(done)
+		for i := 0; i < 100; i++ {
+			Info("logging while Initialize is called again")
+		}
+	}()
+	if err := Initialize(); err != nil {
+		t.Fatalf("second Initialize returned error: %v", err)
+	}
+	<-done
+
+	data, err := os.ReadFile(GetLogLocation())
+	if err != nil {
+		t.Fatalf("failed to read log file: %v", err)
+	}
+	if starts := strings.Count(string(data), "Hacktoberfest CLI started"); starts != 1 {
+		t.Errorf("logger was set up %d times, want once", starts)
+	}
+}
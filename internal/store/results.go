@@ -0,0 +1,62 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+
+	"hacktober/internal/github"
+)
+
+// LastResults is the most recent page of repository search results, saved
+// so the next launch can show it while a fresh search runs
+type LastResults struct {
+	SavedAt        time.Time            `json:"saved_at"`
+	Languages      []string             `json:"languages"`
+	MinStars       int                  `json:"min_stars"`
+	MaxStars       int                  `json:"max_stars,omitempty"`
+	Page           int                  `json:"page"`
+	Repos          []*github.Repository `json:"repos"`
+	TotalAvailable int                  `json:"total_available"`
+	CandidateCount int                  `json:"candidate_count"`
+	HasMore        bool                 `json:"has_more"`
+}
+
+// GetLastResultsLocation returns the path of the saved search results
+func GetLastResultsLocation() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "last_results.json")
+}
+
+// LoadLastResults reads the saved search results, returning nil if there are none
+func LoadLastResults() (*LastResults, error) {
+	data, err := os.ReadFile(GetLastResultsLocation())
+	if errors.Is(err, os.ErrNotExist) {
+		return nil, nil
+	} else if err != nil {
+		return nil, err
+	}
+
+	results := &LastResults{}
+	if err := json.Unmarshal(data, results); err != nil {
+		return nil, err
+	}
+	return results, nil
+}
+
+// Save writes the search results to disk
+func (r *LastResults) Save() error {
+	path := GetLastResultsLocation()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.Marshal(r)
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
@@ -0,0 +1,80 @@
+package store
+
+import (
+	"encoding/json"
+	"errors"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+// Store holds local state that persists across sessions
+type Store struct {
+	LastVisits map[string]time.Time  `json:"last_visits"` // keyed by "owner/repo"
+	Completed  map[string]Completion `json:"completed"`   // keyed by "owner/repo#number"
+	Snoozed    map[string]time.Time  `json:"snoozed"`     // snooze-until time, keyed by "owner/repo#number"
+	Seen       map[string]time.Time  `json:"seen"`        // when an issue was dismissed as seen, keyed by "owner/repo#number"
+}
+
+// Completion records an issue the user has submitted a pull request for
+type Completion struct {
+	Title       string    `json:"title"`
+	IssueURL    string    `json:"issue_url"`
+	PRURL       string    `json:"pr_url,omitempty"`
+	CompletedAt time.Time `json:"completed_at"`
+}
+
+// GetStoreLocation returns the path of the local store file
+func GetStoreLocation() string {
+	homeDir, _ := os.UserHomeDir()
+	return filepath.Join(homeDir, ".hacktober", "store.json")
+}
+
+// Load reads the local store, returning an empty store if none exists yet.
+// On error an empty, usable store is returned alongside it.
+func Load() (*Store, error) {
+	s := &Store{}
+
+	data, err := os.ReadFile(GetStoreLocation())
+	if err == nil {
+		if err = json.Unmarshal(data, s); err != nil {
+			s = &Store{}
+		}
+	} else if errors.Is(err, os.ErrNotExist) {
+		err = nil
+	}
+
+	s.init()
+	return s, err
+}
+
+// Save writes the local store to disk
+func (s *Store) Save() error {
+	path := GetStoreLocation()
+	if err := os.MkdirAll(filepath.Dir(path), 0755); err != nil {
+		return err
+	}
+
+	data, err := json.MarshalIndent(s, "", "  ")
+	if err != nil {
+		return err
+	}
+
+	return os.WriteFile(path, data, 0644)
+}
+
+// init makes sure all maps are usable after loading
+func (s *Store) init() {
+	if s.LastVisits == nil {
+		s.LastVisits = make(map[string]time.Time)
+	}
+	if s.Snoozed == nil {
+		s.Snoozed = make(map[string]time.Time)
+	}
+	if s.Seen == nil {
+		s.Seen = make(map[string]time.Time)
+	}
+	if s.Completed == nil {
+		s.Completed = make(map[string]Completion)
+	}
+}